@@ -1,12 +1,20 @@
 package openstack
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
 	"regexp"
+	"runtime"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
@@ -14,8 +22,12 @@ import (
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servergroups"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/tokens"
 	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/monitors"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
@@ -31,6 +43,7 @@ import (
 	"github.com/gophercloud/gophercloud/v2/pagination"
 	"github.com/gophercloud/utils/v2/openstack/clientconfig"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
 	k8serrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/wait"
 
@@ -74,7 +87,512 @@ type ClusterUninstaller struct {
 	Filter Filter
 	// InfraID contains unique cluster identifier
 	InfraID string
-	Logger  logrus.FieldLogger
+	// DryRun, when set, makes Run() enumerate and log every resource that
+	// would be deleted without issuing any Delete/Update calls.
+	DryRun bool
+	// ProjectID, ProjectName, DomainID and UserID optionally scope the
+	// destroy run to a single Keystone project, so a credential with access
+	// to multiple projects can safely uninstall a cluster in exactly one of
+	// them even if another project happens to have resources tagged with
+	// the same InfraID.
+	ProjectID   string
+	ProjectName string
+	DomainID    string
+	UserID      string
+	// DestroyConcurrency bounds how many delete calls made through
+	// parallelDelete may be in flight at once. Kinds without a PerServiceQPS
+	// override share one such bound across the destroy run; each kind with
+	// an override gets a bound of its own. Defaults to
+	// defaultDestroyConcurrency when zero. Servers, server groups, ports
+	// (always 10 workers), security groups and amphora resources are deleted
+	// outside parallelDelete and are not bound by it.
+	DestroyConcurrency int
+	// DestroyQPS, when non-zero, throttles the delete calls made through
+	// parallelDelete across the whole destroy run to at most this many
+	// requests per second.
+	DestroyQPS float64
+	// PerServiceQPS overrides DestroyQPS for individual resource kinds (the
+	// same kind names Only/Skip use, e.g. "loadbalancers", "subnets"),
+	// so a cloud with a tight Octavia rate limit but a generous Neutron one
+	// doesn't have to throttle every delete call down to the stricter rate.
+	PerServiceQPS map[string]float64
+	// Only, when non-empty, restricts Run and Plan to the named resource
+	// kinds (e.g. "networks", "volumes"). Skip takes precedence over Only.
+	Only []string
+	// Skip excludes the named resource kinds from Run and Plan.
+	Skip []string
+	// DestroyEventsPath, when set, streams one JSON DestroyEvent per delete
+	// attempt to this destination: "stdout-json" (or "-") writes to stdout,
+	// an http(s):// URL posts each event to a webhook, and anything else is
+	// treated as a JSONL file path.
+	DestroyEventsPath string
+	// RouterPolicy controls how clearRouterInterfaces treats a router that
+	// predates the cluster and isn't tagged as cluster-owned. Defaults to
+	// RouterPolicyPreserveCustom.
+	RouterPolicy RouterPolicy
+	// AssetDir, when set, is the directory Run() writes the pre-destroy
+	// destroy-checkpoint-<timestamp>.json snapshot to. Defaults to the
+	// current directory.
+	AssetDir string
+	// Force, when set, lets deleteShares, deleteShareSnapshots and
+	// deleteVolumeSnapshots fall back to admin actions (Manila's
+	// reset_status, Cinder's os-reset_status/os-force_delete) on a share or
+	// snapshot stuck in an error state that a plain Delete can't clear.
+	// These actions require the admin role.
+	Force  bool
+	Logger logrus.FieldLogger
+}
+
+// RouterPolicy controls how clearRouterInterfaces treats a router that
+// isn't tagged as belonging to this cluster, i.e. one that predates the
+// install (BYON) or is shared with other tenants/clusters.
+type RouterPolicy string
+
+const (
+	// RouterPolicyPreserveCustom leaves interfaces on subnets that aren't
+	// part of this cluster attached to the router. This is the default and
+	// matches destroy's historical behavior.
+	RouterPolicyPreserveCustom RouterPolicy = "PreserveCustom"
+	// RouterPolicyDetachAll detaches every interface on a shared router,
+	// including those on subnets that aren't part of this cluster.
+	// Interfaces on cluster subnets are detached under every policy.
+	RouterPolicyDetachAll RouterPolicy = "DetachAll"
+	// RouterPolicyAdopt deletes a shared router outright once every one of
+	// its remaining interfaces belongs to a cluster subnet, on the theory
+	// that nothing else was using it.
+	RouterPolicyAdopt RouterPolicy = "Adopt"
+)
+
+// defaultDestroyConcurrency is used when ClusterUninstaller.DestroyConcurrency
+// isn't set, matching the per-function worker counts the serial delete loops
+// used before they shared a pool.
+const defaultDestroyConcurrency = 10
+
+// destroyPool bounds how many concurrent delete calls are in flight for a
+// resource kind and, optionally, throttles them to a target QPS shared
+// across the whole destroy run.
+type destroyPool struct {
+	sem     chan struct{}
+	limiter *rate.Limiter
+
+	mu             sync.Mutex
+	throttledUntil time.Time
+}
+
+func newDestroyPool(concurrency int, qps float64) *destroyPool {
+	if concurrency <= 0 {
+		concurrency = defaultDestroyConcurrency
+	}
+	pool := &destroyPool{sem: make(chan struct{}, concurrency)}
+	if qps > 0 {
+		burst := int(qps)
+		if burst < 1 {
+			burst = 1
+		}
+		pool.limiter = rate.NewLimiter(rate.Limit(qps), burst)
+	}
+	return pool
+}
+
+// run acquires a slot in the pool, waits for the rate limiter if one is
+// configured, backs off if a prior job was throttled by the server, and
+// then calls fn.
+func (p *destroyPool) run(ctx context.Context, fn func()) {
+	p.sem <- struct{}{}
+	defer func() { <-p.sem }()
+	if p.limiter != nil {
+		if err := p.limiter.Wait(ctx); err != nil {
+			// ctx was canceled while we were waiting for a rate-limiter
+			// slot: don't run fn, so a canceled destroy actually stops
+			// dispatching new work instead of proceeding anyway.
+			return
+		}
+	}
+	if ctx.Err() != nil {
+		return
+	}
+	if wait := p.waitUntilThrottleCleared(); wait > 0 {
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return
+		}
+	}
+	fn()
+}
+
+// waitUntilThrottleCleared returns how long the caller should sleep before
+// proceeding, if a Retry-After header seen by another job in the pool is
+// still in effect.
+func (p *destroyPool) waitUntilThrottleCleared() time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return time.Until(p.throttledUntil)
+}
+
+// throttle pauses the whole pool until at least d has elapsed, so that a
+// single Retry-After response from Neutron/Cinder/Octavia backs off every
+// in-flight worker rather than just the job that received it.
+func (p *destroyPool) throttle(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	until := time.Now().Add(d)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if until.After(p.throttledUntil) {
+		p.throttledUntil = until
+	}
+}
+
+// retryAfterDuration extracts the Retry-After duration gophercloud parsed
+// off a 429 response, if err is one.
+func retryAfterDuration(err error) (time.Duration, bool) {
+	var tooManyRequests gophercloud.ErrDefault429
+	if errors.As(err, &tooManyRequests) {
+		return tooManyRequests.RetryAfter, tooManyRequests.RetryAfter > 0
+	}
+	return 0, false
+}
+
+type destroyPoolContextKey struct{}
+
+func withDestroyPool(ctx context.Context, pool *destroyPool) context.Context {
+	return context.WithValue(ctx, destroyPoolContextKey{}, pool)
+}
+
+// destroyPoolFromContext returns the pool installed by withDestroyPool, or a
+// pool with default settings if the context doesn't carry one (e.g. in unit
+// tests that call a delete* function directly).
+func destroyPoolFromContext(ctx context.Context) *destroyPool {
+	if pool, ok := ctx.Value(destroyPoolContextKey{}).(*destroyPool); ok {
+		return pool
+	}
+	return newDestroyPool(defaultDestroyConcurrency, 0)
+}
+
+type perServicePoolsContextKey struct{}
+
+// perServicePools holds a lazily-built destroyPool per resource kind that has
+// its own PerServiceQPS override, each sharing the concurrency bound of the
+// destroy-wide pool they were derived from but rate-limited independently.
+type perServicePools struct {
+	base      *destroyPool
+	qpsByKind map[string]float64
+
+	mu         sync.Mutex
+	poolByKind map[string]*destroyPool
+}
+
+// withPerServiceQPS installs qpsByKind so parallelDelete can route a kind's
+// jobs through a pool throttled at its own rate instead of the destroy-wide
+// pool installed by withDestroyPool.
+func withPerServiceQPS(ctx context.Context, qpsByKind map[string]float64) context.Context {
+	if len(qpsByKind) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, perServicePoolsContextKey{}, &perServicePools{
+		base:       destroyPoolFromContext(ctx),
+		qpsByKind:  qpsByKind,
+		poolByKind: make(map[string]*destroyPool),
+	})
+}
+
+// poolForKind returns the destroyPool parallelDelete should use for kind: a
+// dedicated pool rate-limited at PerServiceQPS[kind] if one was configured,
+// otherwise the destroy-wide pool installed on ctx.
+func poolForKind(ctx context.Context, kind string) *destroyPool {
+	services, ok := ctx.Value(perServicePoolsContextKey{}).(*perServicePools)
+	if !ok {
+		return destroyPoolFromContext(ctx)
+	}
+	qps, ok := services.qpsByKind[kind]
+	if !ok || qps <= 0 {
+		return services.base
+	}
+
+	services.mu.Lock()
+	defer services.mu.Unlock()
+	if pool, ok := services.poolByKind[kind]; ok {
+		return pool
+	}
+	pool := newDestroyPool(cap(services.base.sem), qps)
+	services.poolByKind[kind] = pool
+	return pool
+}
+
+type routerPolicyContextKey struct{}
+
+// withRouterPolicy returns a context that makes clearRouterInterfaces
+// handle non-cluster routers according to policy.
+func withRouterPolicy(ctx context.Context, policy RouterPolicy) context.Context {
+	return context.WithValue(ctx, routerPolicyContextKey{}, policy)
+}
+
+// routerPolicyFromContext returns the RouterPolicy installed by
+// withRouterPolicy, defaulting to RouterPolicyPreserveCustom if the context
+// doesn't carry one or carries the zero value.
+func routerPolicyFromContext(ctx context.Context) RouterPolicy {
+	if policy, ok := ctx.Value(routerPolicyContextKey{}).(RouterPolicy); ok && policy != "" {
+		return policy
+	}
+	return RouterPolicyPreserveCustom
+}
+
+type forceContextKey struct{}
+
+// withForce returns a context that makes deleteShares, deleteShareSnapshots
+// and deleteVolumeSnapshots attempt an admin reset_status/force_delete
+// fallback when force is true and a plain Delete fails for a reason other
+// than 404.
+func withForce(ctx context.Context, force bool) context.Context {
+	return context.WithValue(ctx, forceContextKey{}, force)
+}
+
+// forceFromContext returns the force setting installed by withForce,
+// defaulting to false if the context doesn't carry one.
+func forceFromContext(ctx context.Context) bool {
+	force, _ := ctx.Value(forceContextKey{}).(bool)
+	return force
+}
+
+// parallelDelete runs every job using the destroyPool installed on ctx for
+// kind (falling back to the destroy-wide pool unless PerServiceQPS gave kind
+// its own rate limit), capping concurrency and QPS, and aggregates per-job
+// failures instead of aborting the batch on the first error.
+func parallelDelete(ctx context.Context, kind string, jobs []func() error) (int, error) {
+	pool := poolForKind(ctx, kind)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	deleted := 0
+	var errs []error
+
+	for _, job := range jobs {
+		wg.Add(1)
+		job := job
+		go func() {
+			defer wg.Done()
+			pool.run(ctx, func() {
+				if err := job(); err != nil {
+					if d, ok := retryAfterDuration(err); ok {
+						pool.throttle(d)
+					}
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+					return
+				}
+				mu.Lock()
+				deleted++
+				mu.Unlock()
+			})
+		}()
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return deleted, k8serrors.NewAggregate(errs)
+	}
+	return deleted, nil
+}
+
+// dryRunObject is a single resource an operator would see removed by a real
+// destroy, recorded by planOrDelete instead of being deleted.
+type dryRunObject struct {
+	ID   string            `json:"id"`
+	Name string            `json:"name,omitempty"`
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// dryRunReport accumulates the objects discovered while DryRun is set, keyed
+// by resource kind (e.g. "Port", "LoadBalancer"), so Run() can print a single
+// structured summary once every deleteFunc has finished.
+type dryRunReport struct {
+	mu        sync.Mutex
+	Resources map[string][]dryRunObject
+}
+
+func newDryRunReport() *dryRunReport {
+	return &dryRunReport{Resources: map[string][]dryRunObject{}}
+}
+
+func (r *dryRunReport) add(kind, id, name string, tags map[string]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Resources[kind] = append(r.Resources[kind], dryRunObject{ID: id, Name: name, Tags: tags})
+}
+
+type dryRunContextKey struct{}
+
+// withDryRunReport returns a context that will make planOrDelete record
+// objects into report instead of deleting them.
+func withDryRunReport(ctx context.Context, report *dryRunReport) context.Context {
+	return context.WithValue(ctx, dryRunContextKey{}, report)
+}
+
+func dryRunReportFromContext(ctx context.Context) (*dryRunReport, bool) {
+	report, ok := ctx.Value(dryRunContextKey{}).(*dryRunReport)
+	return report, ok
+}
+
+// planOrDelete records kind/id/name/tags into the dry-run report carried by
+// ctx and returns nil instead of calling del when the context is in dry-run
+// mode; otherwise it calls del to actually delete the resource. Either way,
+// it emits a DestroyEvent to the event sink carried by ctx, if any.
+func planOrDelete(ctx context.Context, kind, id, name string, tags map[string]string, del func() error) error {
+	if report, ok := dryRunReportFromContext(ctx); ok {
+		report.add(kind, id, name, tags)
+		emitDestroyEvent(ctx, kind, id, name, "plan", "planned", nil, 0)
+		return nil
+	}
+
+	start := time.Now()
+	err := del()
+	duration := time.Since(start)
+	result := "deleted"
+	if err != nil {
+		result = "failed"
+	}
+	emitDestroyEvent(ctx, kind, id, name, "delete", result, err, duration)
+	return err
+}
+
+// DestroyEvent is one structured record of a delete attempt against a
+// single resource, meant for machine consumption: CI can post-process a
+// stream of these to spot leaked resources, correlate failures across
+// retries, or compute time spent per kind, none of which the free-form
+// logger.Debugf/Errorf lines support.
+type DestroyEvent struct {
+	Timestamp  time.Time `json:"timestamp"`
+	Kind       string    `json:"kind"`
+	ID         string    `json:"id"`
+	Name       string    `json:"name,omitempty"`
+	Action     string    `json:"action"`
+	Result     string    `json:"result"`
+	Error      string    `json:"error,omitempty"`
+	DurationMs int64     `json:"durationMs"`
+	ClusterID  string    `json:"clusterId,omitempty"`
+}
+
+// eventSink receives DestroyEvents as they're produced. Implementations
+// must be safe for concurrent use: delete* functions run concurrently
+// across the destroy DAG and within a single resource kind's destroyPool.
+type eventSink interface {
+	Emit(event DestroyEvent)
+}
+
+// stdoutEventSink writes one JSON object per line to stdout, for
+// DestroyEventsPath "stdout-json" (and its "-" shorthand).
+type stdoutEventSink struct {
+	mu sync.Mutex
+}
+
+func (s *stdoutEventSink) Emit(event DestroyEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := json.NewEncoder(os.Stdout).Encode(event); err != nil {
+		logrus.Errorf("failed to write destroy event: %v", err)
+	}
+}
+
+// fileEventSink appends one JSON object per line to a file, for a
+// DestroyEventsPath such as "path.jsonl".
+type fileEventSink struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+func newFileEventSink(path string) (*fileEventSink, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open destroy events file %q: %w", path, err)
+	}
+	return &fileEventSink{f: f}, nil
+}
+
+func (s *fileEventSink) Emit(event DestroyEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := json.NewEncoder(s.f).Encode(event); err != nil {
+		logrus.Errorf("failed to write destroy event: %v", err)
+	}
+}
+
+// webhookEventSink POSTs each event as a JSON body to a URL, for a
+// DestroyEventsPath starting with http:// or https://.
+type webhookEventSink struct {
+	url    string
+	client *http.Client
+}
+
+func newWebhookEventSink(url string) *webhookEventSink {
+	return &webhookEventSink{url: url, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (s *webhookEventSink) Emit(event DestroyEvent) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		logrus.Errorf("failed to marshal destroy event: %v", err)
+		return
+	}
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		logrus.Errorf("failed to post destroy event %q: %v", event.ID, err)
+		return
+	}
+	resp.Body.Close()
+}
+
+// newEventSink resolves a DestroyEventsPath value into the sink that
+// should receive it.
+func newEventSink(destination string) (eventSink, error) {
+	switch {
+	case destination == "stdout-json", destination == "-":
+		return &stdoutEventSink{}, nil
+	case strings.HasPrefix(destination, "http://"), strings.HasPrefix(destination, "https://"):
+		return newWebhookEventSink(destination), nil
+	default:
+		return newFileEventSink(destination)
+	}
+}
+
+type eventSinkContextKey struct{}
+
+// eventEmitter pairs an eventSink with the cluster ID every event from this
+// destroy run should be stamped with.
+type eventEmitter struct {
+	sink      eventSink
+	clusterID string
+}
+
+// withEventSink returns a context that makes planOrDelete emit a
+// DestroyEvent to sink for every delete attempt.
+func withEventSink(ctx context.Context, sink eventSink, clusterID string) context.Context {
+	return context.WithValue(ctx, eventSinkContextKey{}, &eventEmitter{sink: sink, clusterID: clusterID})
+}
+
+// emitDestroyEvent is a no-op unless ctx carries an eventEmitter installed
+// by withEventSink.
+func emitDestroyEvent(ctx context.Context, kind, id, name, action, result string, err error, duration time.Duration) {
+	emitter, ok := ctx.Value(eventSinkContextKey{}).(*eventEmitter)
+	if !ok {
+		return
+	}
+	event := DestroyEvent{
+		Timestamp:  time.Now(),
+		Kind:       kind,
+		ID:         id,
+		Name:       name,
+		Action:     action,
+		Result:     result,
+		DurationMs: duration.Milliseconds(),
+		ClusterID:  emitter.clusterID,
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	emitter.sink.Emit(event)
 }
 
 // New returns an OpenStack destroyer from ClusterMetadata.
@@ -89,8 +607,10 @@ func New(logger logrus.FieldLogger, metadata *types.ClusterMetadata) (providers.
 
 // Run is the entrypoint to start the uninstall process.
 func (o *ClusterUninstaller) Run() (*types.ClusterQuota, error) {
-	ctx := context.TODO()
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
 	opts := openstackdefaults.DefaultClientOpts(o.Cloud)
+	applyProjectScope(opts, o)
 
 	// Check that the cloud has the minimum requirements for the destroy
 	// script to work properly.
@@ -98,46 +618,60 @@ func (o *ClusterUninstaller) Run() (*types.ClusterQuota, error) {
 		return nil, err
 	}
 
-	// deleteFuncs contains the functions that will be launched as
-	// goroutines.
-	deleteFuncs := map[string]deleteFunc{
-		"cleanVIPsPorts":        cleanVIPsPorts,
-		"deleteServers":         deleteServers,
-		"deleteServerGroups":    deleteServerGroups,
-		"deleteTrunks":          deleteTrunks,
-		"deleteLoadBalancers":   deleteLoadBalancers,
-		"deletePorts":           deletePortsByFilter,
-		"deleteSecurityGroups":  deleteSecurityGroups,
-		"clearRouterInterfaces": clearRouterInterfaces,
-		"deleteSubnets":         deleteSubnets,
-		"deleteNetworks":        deleteNetworks,
-		"deleteContainers":      deleteContainers,
-		"deleteVolumes":         deleteVolumes,
-		"deleteShares":          deleteShares,
-		"deleteVolumeSnapshots": deleteVolumeSnapshots,
-		"deleteFloatingIPs":     deleteFloatingIPs,
-		"deleteImages":          deleteImages,
-	}
-	returnChannel := make(chan string)
-
-	// launch goroutines
-	for name, function := range deleteFuncs {
-		go deleteRunner(ctx, name, function, opts, o.Filter, o.Logger, returnChannel)
-	}
-
-	// wait for them to finish
-	for i := 0; i < len(deleteFuncs); i++ {
-		res := <-returnChannel
-		o.Logger.Debugf("goroutine %v complete", res)
-	}
-
-	// we want to remove routers as the last thing as it requires detaching the
-	// FIPs and that will cause it impossible to track which FIPs are tied to
-	// LBs being deleted.
-	err := deleteRouterRunner(ctx, opts, o.Filter, o.Logger)
+	if err := validateProjectScope(ctx, opts, o, o.Logger); err != nil {
+		return nil, err
+	}
+
+	ctx = withDestroyPool(ctx, newDestroyPool(o.DestroyConcurrency, o.DestroyQPS))
+	ctx = withPerServiceQPS(ctx, o.PerServiceQPS)
+	ctx = withRouterPolicy(ctx, o.RouterPolicy)
+	ctx = withForce(ctx, o.Force)
+
+	if o.DestroyEventsPath != "" {
+		sink, err := newEventSink(o.DestroyEventsPath)
+		if err != nil {
+			return nil, err
+		}
+		ctx = withEventSink(ctx, sink, o.Filter["openshiftClusterID"])
+	}
+
+	var report *dryRunReport
+	if o.DryRun {
+		report = newDryRunReport()
+		ctx = withDryRunReport(ctx, report)
+		o.Logger.Info("Dry run: no resources will be deleted, only logged")
+	}
+
+	// Snapshot everything we're about to delete before issuing any Delete
+	// calls, so an accidental destroy has a recovery path via Restore.
+	if !o.DryRun {
+		if snapshot, err := o.Checkpoint(ctx, o.Filter); err != nil {
+			o.Logger.Errorf("failed to checkpoint cluster resources before destroy: %v", err)
+		} else if err := writeCheckpoint(o.AssetDir, snapshot); err != nil {
+			o.Logger.Errorf("failed to write destroy checkpoint: %v", err)
+		}
+	}
+
+	clusterID := o.Filter["openshiftClusterID"]
+	skip := o.Skip
+	if progress, err := readProgressCheckpoint(o.AssetDir, clusterID); err != nil {
+		o.Logger.Errorf("failed to read destroy progress checkpoint: %v", err)
+	} else if progress != nil && len(progress.Finished) > 0 {
+		o.Logger.Infof("resuming destroy: skipping resource kinds already confirmed empty: %v", progress.Finished)
+		skip = append(append([]string{}, skip...), progress.Finished...)
+	}
+
+	destroyDAG := filterDAG(destroyGraph(), o.Only, skip)
+	tracker := newProgressTracker(destroyDAG)
+	installSignalHandler(cancel, tracker, o.AssetDir, clusterID, o.Logger)
+
+	metrics, err := runDAG(ctx, destroyDAG, opts, o.Filter, o.Logger, tracker)
 	if err != nil {
 		return nil, err
 	}
+	for _, m := range metrics {
+		o.Logger.Debugf("node %v finished in %dms after %d attempt(s)", m.Name, m.DurationMs, m.Attempts)
+	}
 
 	// we need to untag the custom network if it was provided by the user
 	err = untagRunner(ctx, opts, o.InfraID, o.Logger)
@@ -145,26 +679,710 @@ func (o *ClusterUninstaller) Run() (*types.ClusterQuota, error) {
 		return nil, err
 	}
 
+	if report != nil {
+		summary, err := json.MarshalIndent(report.Resources, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal dry-run report: %w", err)
+		}
+		o.Logger.Infof("Dry run summary of resources that would be deleted:\n%s", summary)
+	}
+
 	return nil, nil
 }
 
-func deleteRunner(ctx context.Context, deleteFuncName string, dFunction deleteFunc, opts *clientconfig.ClientOpts, filter Filter, logger logrus.FieldLogger, channel chan string) {
-	backoffSettings := wait.Backoff{
-		Duration: time.Second * 15,
-		Factor:   1.3,
-		Steps:    25,
+// DestroyPlan is the set of resources Plan found would be removed by a real
+// destroy, grouped by resource kind (e.g. "Port", "LoadBalancer").
+type DestroyPlan struct {
+	Resources map[string][]dryRunObject `json:"resources"`
+}
+
+// Plan lists the resources a destroy would remove without deleting anything.
+// It walks the same dependency graph Run uses, with every delete* function
+// resolving through planOrDelete instead of issuing real API calls, so the
+// plan reflects the exact same ordering and filtering destroy would apply.
+func (o *ClusterUninstaller) Plan(ctx context.Context, filter Filter) (*DestroyPlan, error) {
+	opts := openstackdefaults.DefaultClientOpts(o.Cloud)
+	applyProjectScope(opts, o)
+
+	if err := validateCloud(ctx, opts, o.Logger); err != nil {
+		return nil, err
+	}
+	if err := validateProjectScope(ctx, opts, o, o.Logger); err != nil {
+		return nil, err
 	}
 
-	err := wait.ExponentialBackoff(backoffSettings, func() (bool, error) {
-		return dFunction(ctx, opts, filter, logger)
-	})
+	ctx = withDestroyPool(ctx, newDestroyPool(o.DestroyConcurrency, o.DestroyQPS))
+	ctx = withPerServiceQPS(ctx, o.PerServiceQPS)
+	report := newDryRunReport()
+	ctx = withDryRunReport(ctx, report)
+
+	nodes := filterDAG(destroyGraph(), o.Only, o.Skip)
+	if _, err := runDAG(ctx, nodes, opts, filter, o.Logger, nil); err != nil {
+		return nil, err
+	}
+
+	return &DestroyPlan{Resources: report.Resources}, nil
+}
+
+// destroyGraph declares the dependency graph between resource kinds shared
+// by Run and Plan. A node only starts once every node in dependsOn has
+// reported done, replacing the previous fixed fan-out where e.g. ports were
+// retried over and over against trunks that weren't gone yet. Independent
+// kinds (volumes, volume snapshots, shares, images, containers) have no
+// dependencies and run as soon as the DAG starts, same as before.
+func destroyGraph() []dagNode {
+	return []dagNode{
+		{name: "deleteServers", fn: deleteServers},
+		{name: "deleteServerGroups", fn: deleteServerGroups},
+		{name: "deleteTrunks", fn: deleteTrunks, dependsOn: []string{"deleteServers"}},
+		{name: "deletePorts", fn: deletePortsByFilter, dependsOn: []string{"deleteTrunks"}},
+		{name: "deleteLoadBalancers", fn: deleteLoadBalancers, dependsOn: []string{"deletePorts"}},
+		{name: "reapAmphoraResources", fn: reapAmphoraResources, dependsOn: []string{"deleteLoadBalancers"}},
+		// cleanVIPsPorts dissociates the FIPs and strips the security groups
+		// the cluster added to user-provided API/Ingress ports; it has to run
+		// after the load balancers fronting those ports are actually gone,
+		// not just after Delete was accepted.
+		{name: "cleanVIPsPorts", fn: cleanVIPsPorts, dependsOn: []string{"deleteLoadBalancers"}},
+		{name: "deleteFloatingIPs", fn: deleteFloatingIPs, dependsOn: []string{"reapAmphoraResources"}},
+		{name: "deleteSecurityGroups", fn: deleteSecurityGroups, dependsOn: []string{"deleteFloatingIPs"}},
+		{name: "clearRouterInterfaces", fn: clearRouterInterfaces, dependsOn: []string{"deleteSecurityGroups"}},
+		{name: "deleteSubnets", fn: deleteSubnets, dependsOn: []string{"clearRouterInterfaces"}},
+		{name: "deleteNetworks", fn: deleteNetworks, dependsOn: []string{"deleteSubnets"}},
+		{name: "deleteRouters", fn: deleteRouters, dependsOn: []string{"deleteNetworks"}},
+		{name: "deleteVolumes", fn: deleteVolumes},
+		{name: "deleteVolumeSnapshots", fn: deleteVolumeSnapshots},
+		{name: "deleteShares", fn: deleteShares},
+		{name: "deleteImages", fn: deleteImages},
+		{name: "deleteContainers", fn: deleteContainers},
+	}
+}
+
+// kindName derives the Only/Skip friendly name for a DAG node, e.g.
+// "deleteNetworks" becomes "networks" and "clearRouterInterfaces" becomes
+// "routerinterfaces".
+func kindName(nodeName string) string {
+	for _, prefix := range []string{"delete", "clean", "clear", "reap"} {
+		if trimmed := strings.TrimPrefix(nodeName, prefix); trimmed != nodeName {
+			return strings.ToLower(trimmed)
+		}
+	}
+	return strings.ToLower(nodeName)
+}
+
+// filterDAG returns the subset of nodes selected by only, if non-empty
+// (an allow-list), minus any excluded by skip. Nodes depending on one that
+// got filtered out aren't blocked: runDAG already treats a dependency it
+// doesn't recognize as satisfied.
+func filterDAG(nodes []dagNode, only, skip []string) []dagNode {
+	onlySet := toKindSet(only)
+	skipSet := toKindSet(skip)
+
+	filtered := make([]dagNode, 0, len(nodes))
+	for _, node := range nodes {
+		kind := kindName(node.name)
+		if len(onlySet) > 0 && !onlySet[kind] {
+			continue
+		}
+		if skipSet[kind] {
+			continue
+		}
+		filtered = append(filtered, node)
+	}
+	return filtered
+}
+
+func toKindSet(kinds []string) map[string]bool {
+	set := make(map[string]bool, len(kinds))
+	for _, kind := range kinds {
+		set[strings.ToLower(strings.TrimSpace(kind))] = true
+	}
+	return set
+}
+
+// destroyProgress is the on-disk record of how far a destroy got before it
+// was interrupted: which resource kinds are confirmed empty (Finished) and
+// which were still being worked on (Pending) when the checkpoint was
+// written. A later invocation reads it back so it can skip the finished
+// kinds instead of re-enumerating resources that are already gone.
+type destroyProgress struct {
+	ClusterID string   `json:"clusterId"`
+	Finished  []string `json:"finished"`
+	Pending   []string `json:"pending"`
+}
+
+// progressCheckpointPath is where the progress checkpoint for clusterID is
+// written under assetDir, separate from the pre-destroy ClusterSnapshot
+// checkpoint written by Checkpoint/writeCheckpoint.
+func progressCheckpointPath(assetDir, clusterID string) string {
+	if assetDir == "" {
+		assetDir = "."
+	}
+	return filepath.Join(assetDir, fmt.Sprintf("destroy-progress-%s.json", clusterID))
+}
+
+// progressTracker records, as the DAG runs, which resource kinds have been
+// confirmed deleted so a signal handler can flush an accurate checkpoint at
+// any point without waiting for the whole destroy to finish.
+type progressTracker struct {
+	mu       sync.Mutex
+	all      []string
+	finished map[string]bool
+}
+
+// newProgressTracker seeds a tracker with every node in the DAG that's
+// actually going to run, so snapshot can report the remaining kinds as
+// pending even before any of them finish.
+func newProgressTracker(nodes []dagNode) *progressTracker {
+	all := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		all = append(all, node.name)
+	}
+	return &progressTracker{all: all, finished: make(map[string]bool, len(nodes))}
+}
+
+func (p *progressTracker) markFinished(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.finished[name] = true
+}
+
+// snapshot renders the tracker's current state as a destroyProgress, in
+// Only/Skip friendly kind names so it can be fed straight back into
+// filterDAG on the next invocation.
+func (p *progressTracker) snapshot(clusterID string) destroyProgress {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	progress := destroyProgress{ClusterID: clusterID}
+	for _, name := range p.all {
+		kind := kindName(name)
+		if p.finished[name] {
+			progress.Finished = append(progress.Finished, kind)
+		} else {
+			progress.Pending = append(progress.Pending, kind)
+		}
+	}
+	return progress
+}
+
+// writeProgressCheckpoint persists progress so the next destroy invocation
+// can pick up where this one left off.
+func writeProgressCheckpoint(assetDir string, progress destroyProgress) error {
+	data, err := json.MarshalIndent(progress, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal destroy progress: %w", err)
+	}
+	return os.WriteFile(progressCheckpointPath(assetDir, progress.ClusterID), data, 0644)
+}
+
+// readProgressCheckpoint loads a previously written progress checkpoint for
+// clusterID, if one exists. A missing file is not an error: it just means
+// this is the first attempt at destroying this cluster.
+func readProgressCheckpoint(assetDir, clusterID string) (*destroyProgress, error) {
+	data, err := os.ReadFile(progressCheckpointPath(assetDir, clusterID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read destroy progress checkpoint: %w", err)
+	}
+	var progress destroyProgress
+	if err := json.Unmarshal(data, &progress); err != nil {
+		return nil, fmt.Errorf("failed to parse destroy progress checkpoint: %w", err)
+	}
+	return &progress, nil
+}
+
+// signalAction is what installSignalHandler's loop should do in response to
+// a received signal.
+type signalAction int
 
+const (
+	signalActionDumpStacks signalAction = iota
+	signalActionCheckpoint
+	signalActionCheckpointAndCancel
+	signalActionExit
+)
+
+// nextSignalAction decides the three-strike abort policy's action for sig,
+// given how many non-SIGQUIT signals (including this one, if it isn't a
+// SIGQUIT) have been received so far: the first strike flushes a progress
+// checkpoint so the next run can resume past whatever already finished, the
+// second additionally cancels the destroy, and the third exits immediately
+// for operators who really mean "stop now". SIGQUIT never counts as a
+// strike; it just dumps every goroutine's stack, for diagnosing a delete
+// call that looks hung. Split out from installSignalHandler so the
+// strike-counting logic can be unit tested without sending real signals.
+func nextSignalAction(sig os.Signal, strikes int) signalAction {
+	if sig == syscall.SIGQUIT {
+		return signalActionDumpStacks
+	}
+	switch strikes {
+	case 1:
+		return signalActionCheckpoint
+	case 2:
+		return signalActionCheckpointAndCancel
+	default:
+		return signalActionExit
+	}
+}
+
+// installSignalHandler wires SIGINT/SIGTERM/SIGQUIT to nextSignalAction's
+// three-strike abort policy.
+func installSignalHandler(cancel context.CancelFunc, tracker *progressTracker, assetDir, clusterID string, logger logrus.FieldLogger) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
+
+	go func() {
+		strikes := 0
+		for sig := range sigCh {
+			if sig != syscall.SIGQUIT {
+				strikes++
+			}
+
+			switch nextSignalAction(sig, strikes) {
+			case signalActionDumpStacks:
+				buf := make([]byte, 1<<20)
+				n := runtime.Stack(buf, true)
+				logger.Warnf("SIGQUIT received, dumping goroutine stacks:\n%s", buf[:n])
+			case signalActionCheckpoint:
+				logger.Warnf("received %v, finishing in-flight operations and writing a progress checkpoint (press again to cancel immediately)", sig)
+				if tracker != nil {
+					if err := writeProgressCheckpoint(assetDir, tracker.snapshot(clusterID)); err != nil {
+						logger.Errorf("failed to write destroy progress checkpoint: %v", err)
+					}
+				}
+			case signalActionCheckpointAndCancel:
+				logger.Warnf("received second %v, canceling destroy (press again to force exit)", sig)
+				if tracker != nil {
+					if err := writeProgressCheckpoint(assetDir, tracker.snapshot(clusterID)); err != nil {
+						logger.Errorf("failed to write destroy progress checkpoint: %v", err)
+					}
+				}
+				cancel()
+			case signalActionExit:
+				logger.Error("received third signal, exiting immediately")
+				os.Exit(1)
+			}
+		}
+	}()
+}
+
+// ClusterSnapshot is a point-in-time recording of every resource Checkpoint
+// found tagged for this cluster: networks, subnets, routers and ports, the
+// Octavia load balancer/listener/pool/member hierarchy, Cinder volumes and
+// their snapshots, and Swift container metadata. It's the same set of
+// resources the delete* functions in this package enumerate via AllPages,
+// serialized so operators have something to recover from an accidental
+// openshift-install destroy cluster invocation.
+type ClusterSnapshot struct {
+	Networks        []networks.Network     `json:"networks"`
+	Subnets         []subnets.Subnet       `json:"subnets"`
+	Routers         []routers.Router       `json:"routers"`
+	Ports           []ports.Port           `json:"ports"`
+	LoadBalancers   []LoadBalancerSnapshot `json:"loadBalancers"`
+	Volumes         []volumes.Volume       `json:"volumes"`
+	VolumeSnapshots []snapshots.Snapshot   `json:"volumeSnapshots"`
+	Containers      []ContainerSnapshot    `json:"containers"`
+}
+
+// LoadBalancerSnapshot nests the listener/pool/member hierarchy Checkpoint
+// walks for each load balancer, mirroring what manualDeleteLoadBalancer
+// tears down by hand.
+type LoadBalancerSnapshot struct {
+	loadbalancers.LoadBalancer
+	Listeners []ListenerSnapshot `json:"listenerDetails"`
+}
+
+// ListenerSnapshot is one listener and the pools attached to it.
+type ListenerSnapshot struct {
+	listeners.Listener
+	Pools []PoolSnapshot `json:"poolDetails"`
+}
+
+// PoolSnapshot is one pool and its members.
+type PoolSnapshot struct {
+	pools.Pool
+	Members []pools.Member `json:"memberDetails"`
+}
+
+// ContainerSnapshot is a Swift container's name and metadata headers.
+type ContainerSnapshot struct {
+	Name     string            `json:"name"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+// Checkpoint lists every resource tagged for this cluster and returns them
+// as a single serializable ClusterSnapshot. Run calls this and writes the
+// result to <AssetDir>/destroy-checkpoint-<timestamp>.json before issuing
+// any Delete calls.
+func (o *ClusterUninstaller) Checkpoint(ctx context.Context, filter Filter) (*ClusterSnapshot, error) {
+	opts := openstackdefaults.DefaultClientOpts(o.Cloud)
+	applyProjectScope(opts, o)
+
+	snapshot := &ClusterSnapshot{}
+	tags := strings.Join(filterTags(filter), ",")
+	clusterID := filter["openshiftClusterID"]
+
+	networkConn, err := openstackdefaults.NewServiceClient(ctx, "network", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	networkPages, err := networks.List(networkConn, networks.ListOpts{TagsAny: tags}).AllPages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list networks: %w", err)
+	}
+	if snapshot.Networks, err = networks.ExtractNetworks(networkPages); err != nil {
+		return nil, fmt.Errorf("failed to extract networks: %w", err)
+	}
+
+	subnetPages, err := subnets.List(networkConn, subnets.ListOpts{TagsAny: tags}).AllPages(ctx)
 	if err != nil {
-		logger.Fatalf("Unrecoverable error/timed out: %v", err)
+		return nil, fmt.Errorf("failed to list subnets: %w", err)
+	}
+	if snapshot.Subnets, err = subnets.ExtractSubnets(subnetPages); err != nil {
+		return nil, fmt.Errorf("failed to extract subnets: %w", err)
 	}
 
-	// record that the goroutine has run to completion
-	channel <- deleteFuncName
+	routerPages, err := routers.List(networkConn, routers.ListOpts{TagsAny: tags}).AllPages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list routers: %w", err)
+	}
+	if snapshot.Routers, err = routers.ExtractRouters(routerPages); err != nil {
+		return nil, fmt.Errorf("failed to extract routers: %w", err)
+	}
+
+	portPages, err := ports.List(networkConn, ports.ListOpts{TagsAny: tags}).AllPages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ports: %w", err)
+	}
+	if snapshot.Ports, err = ports.ExtractPorts(portPages); err != nil {
+		return nil, fmt.Errorf("failed to extract ports: %w", err)
+	}
+
+	// Octavia isn't guaranteed to exist, same as deleteLoadBalancers.
+	lbConn, err := openstackdefaults.NewServiceClient(ctx, "load-balancer", opts)
+	if err != nil {
+		var gerr *gophercloud.ErrEndpointNotFound
+		if !errors.As(err, &gerr) {
+			return nil, err
+		}
+	} else {
+		lbPages, err := loadbalancers.List(lbConn, loadbalancers.ListOpts{}).AllPages(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list load balancers: %w", err)
+		}
+		allLBs, err := loadbalancers.ExtractLoadBalancers(lbPages)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract load balancers: %w", err)
+		}
+		for _, lb := range allLBs {
+			if !strings.Contains(lb.Description, clusterID) {
+				continue
+			}
+			lbSnapshot, err := checkpointLoadBalancer(ctx, lbConn, lb)
+			if err != nil {
+				return nil, err
+			}
+			snapshot.LoadBalancers = append(snapshot.LoadBalancers, lbSnapshot)
+		}
+	}
+
+	volumeConn, err := openstackdefaults.NewServiceClient(ctx, "volume", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	volumePages, err := volumes.List(volumeConn, volumes.ListOpts{}).AllPages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volumes: %w", err)
+	}
+	allVolumes, err := volumes.ExtractVolumes(volumePages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract volumes: %w", err)
+	}
+	for _, volume := range allVolumes {
+		if strings.HasPrefix(volume.Name, clusterID) || volume.Metadata[cinderCSIClusterIDKey] == clusterID {
+			snapshot.Volumes = append(snapshot.Volumes, volume)
+		}
+	}
+
+	snapshotPages, err := snapshots.List(volumeConn, snapshots.ListOpts{}).AllPages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volume snapshots: %w", err)
+	}
+	allSnapshots, err := snapshots.ExtractSnapshots(snapshotPages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract volume snapshots: %w", err)
+	}
+	for _, volSnapshot := range allSnapshots {
+		if val, ok := volSnapshot.Metadata[cinderCSIClusterIDKey]; ok && val == clusterID {
+			snapshot.VolumeSnapshots = append(snapshot.VolumeSnapshots, volSnapshot)
+		}
+	}
+
+	// Swift isn't guaranteed to exist or be usable by this user, same as
+	// deleteContainers.
+	objectConn, err := openstackdefaults.NewServiceClient(ctx, "object-store", opts)
+	if err != nil {
+		var gerr *gophercloud.ErrEndpointNotFound
+		if !errors.As(err, &gerr) {
+			return nil, err
+		}
+		return snapshot, nil
+	}
+
+	containerPages, err := containers.List(objectConn, nil).AllPages(ctx)
+	if err != nil {
+		if gophercloud.ResponseCodeIs(err, http.StatusForbidden) || gophercloud.ResponseCodeIs(err, http.StatusUnauthorized) {
+			return snapshot, nil
+		}
+		return nil, fmt.Errorf("failed to list containers: %w", err)
+	}
+	allContainers, err := containers.ExtractNames(containerPages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract containers: %w", err)
+	}
+	for _, container := range allContainers {
+		metadata, err := containers.Get(ctx, objectConn, container, nil).ExtractMetadata()
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get metadata for container %q: %w", container, err)
+		}
+		for key, val := range filter {
+			// Swift mangles the case so openshiftClusterID becomes
+			// Openshiftclusterid in the X-Container-Meta- HEAD output.
+			titlekey := strings.Title(strings.ToLower(key))
+			if metadata[titlekey] == val {
+				snapshot.Containers = append(snapshot.Containers, ContainerSnapshot{Name: container, Metadata: metadata})
+				break
+			}
+		}
+	}
+
+	return snapshot, nil
+}
+
+// checkpointLoadBalancer walks lb's listener/pool/member hierarchy, the same
+// hierarchy manualDeleteLoadBalancer tears down by hand.
+func checkpointLoadBalancer(ctx context.Context, conn *gophercloud.ServiceClient, lb loadbalancers.LoadBalancer) (LoadBalancerSnapshot, error) {
+	lbSnapshot := LoadBalancerSnapshot{LoadBalancer: lb}
+
+	listenerPages, err := listeners.List(conn, listeners.ListOpts{LoadbalancerID: lb.ID}).AllPages(ctx)
+	if err != nil {
+		return lbSnapshot, fmt.Errorf("failed to list listeners for load balancer %q: %w", lb.ID, err)
+	}
+	allListeners, err := listeners.ExtractListeners(listenerPages)
+	if err != nil {
+		return lbSnapshot, fmt.Errorf("failed to extract listeners for load balancer %q: %w", lb.ID, err)
+	}
+
+	for _, listener := range allListeners {
+		listenerSnapshot := ListenerSnapshot{Listener: listener}
+
+		poolPages, err := pools.List(conn, pools.ListOpts{ListenerID: listener.ID}).AllPages(ctx)
+		if err != nil {
+			return lbSnapshot, fmt.Errorf("failed to list pools for listener %q: %w", listener.ID, err)
+		}
+		allPools, err := pools.ExtractPools(poolPages)
+		if err != nil {
+			return lbSnapshot, fmt.Errorf("failed to extract pools for listener %q: %w", listener.ID, err)
+		}
+
+		for _, pool := range allPools {
+			poolSnapshot := PoolSnapshot{Pool: pool}
+			memberPages, err := pools.ListMembers(conn, pool.ID, pools.ListMembersOpts{}).AllPages(ctx)
+			if err != nil {
+				return lbSnapshot, fmt.Errorf("failed to list members for pool %q: %w", pool.ID, err)
+			}
+			if poolSnapshot.Members, err = pools.ExtractMembers(memberPages); err != nil {
+				return lbSnapshot, fmt.Errorf("failed to extract members for pool %q: %w", pool.ID, err)
+			}
+			listenerSnapshot.Pools = append(listenerSnapshot.Pools, poolSnapshot)
+		}
+		lbSnapshot.Listeners = append(lbSnapshot.Listeners, listenerSnapshot)
+	}
+
+	return lbSnapshot, nil
+}
+
+// writeCheckpoint serializes snapshot to
+// <assetDir>/destroy-checkpoint-<unix-timestamp>.json, defaulting assetDir
+// to the current directory when unset.
+func writeCheckpoint(assetDir string, snapshot *ClusterSnapshot) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal cluster snapshot: %w", err)
+	}
+	if assetDir == "" {
+		assetDir = "."
+	}
+	path := filepath.Join(assetDir, fmt.Sprintf("destroy-checkpoint-%d.json", time.Now().Unix()))
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write checkpoint %q: %w", path, err)
+	}
+	return nil
+}
+
+// Restore best-effort recreates the networks, subnets, routers and ports
+// recorded in snapshot. It doesn't attempt to restore load balancers,
+// volumes or containers: those carry data or depend on compute resources
+// that no longer exist, and recreating the bare API objects without their
+// contents wouldn't give operators anything usable. Restore doesn't
+// reattach restored subnets to restored routers either, since the original
+// gateway/interface configuration isn't something snapshot can replay
+// faithfully; operators get back the networks/subnets/routers/ports
+// themselves, not a re-wired topology.
+func (o *ClusterUninstaller) Restore(ctx context.Context, snapshot *ClusterSnapshot) error {
+	opts := openstackdefaults.DefaultClientOpts(o.Cloud)
+	applyProjectScope(opts, o)
+
+	conn, err := openstackdefaults.NewServiceClient(ctx, "network", opts)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	networkIDs := make(map[string]string, len(snapshot.Networks))
+	for _, network := range snapshot.Networks {
+		adminStateUp := network.AdminStateUp
+		shared := network.Shared
+		created, err := networks.Create(ctx, conn, networks.CreateOpts{
+			Name:         network.Name,
+			AdminStateUp: &adminStateUp,
+			Shared:       &shared,
+		}).Extract()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to restore network %q: %w", network.Name, err))
+			continue
+		}
+		networkIDs[network.ID] = created.ID
+	}
+
+	for _, subnet := range snapshot.Subnets {
+		newNetworkID, ok := networkIDs[subnet.NetworkID]
+		if !ok {
+			errs = append(errs, fmt.Errorf("cannot restore subnet %q: its network %q was not restored", subnet.Name, subnet.NetworkID))
+			continue
+		}
+		_, err := subnets.Create(ctx, conn, subnets.CreateOpts{
+			NetworkID: newNetworkID,
+			Name:      subnet.Name,
+			CIDR:      subnet.CIDR,
+			IPVersion: gophercloud.IPVersion(subnet.IPVersion),
+		}).Extract()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to restore subnet %q: %w", subnet.Name, err))
+		}
+	}
+
+	for _, router := range snapshot.Routers {
+		if _, err := routers.Create(ctx, conn, routers.CreateOpts{Name: router.Name}).Extract(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to restore router %q: %w", router.Name, err))
+		}
+	}
+
+	for _, port := range snapshot.Ports {
+		newNetworkID, ok := networkIDs[port.NetworkID]
+		if !ok {
+			errs = append(errs, fmt.Errorf("cannot restore port %q: its network %q was not restored", port.Name, port.NetworkID))
+			continue
+		}
+		if _, err := ports.Create(ctx, conn, ports.CreateOpts{NetworkID: newNetworkID, Name: port.Name}).Extract(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to restore port %q: %w", port.Name, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("restore completed with errors: %w", k8serrors.NewAggregate(errs))
+	}
+	return nil
+}
+
+// dagNode is one resource-kind node of the destroy dependency graph. It only
+// runs once every node named in dependsOn has completed.
+type dagNode struct {
+	name      string
+	fn        deleteFunc
+	dependsOn []string
+}
+
+// dagNodeMetrics is the per-node outcome of a runDAG call, suitable for
+// logging or serializing into a machine-readable destroy report.
+type dagNodeMetrics struct {
+	Name       string `json:"name"`
+	Attempts   int    `json:"attempts"`
+	DurationMs int64  `json:"durationMs"`
+	Error      string `json:"error,omitempty"`
+}
+
+// runDAG executes nodes concurrently, honoring dependsOn: a node is only
+// started once all of its dependencies have signaled completion. Each node
+// keeps the exponential backoff retry behavior the flat fan-out used to have,
+// but nodes no longer burn retries hammering a dependency that isn't ready
+// yet.
+func runDAG(ctx context.Context, nodes []dagNode, opts *clientconfig.ClientOpts, filter Filter, logger logrus.FieldLogger, tracker *progressTracker) ([]dagNodeMetrics, error) {
+	done := make(map[string]chan struct{}, len(nodes))
+	for _, node := range nodes {
+		done[node.name] = make(chan struct{})
+	}
+
+	var wg sync.WaitGroup
+	metricsCh := make(chan dagNodeMetrics, len(nodes))
+	for _, node := range nodes {
+		wg.Add(1)
+		go func(node dagNode) {
+			defer wg.Done()
+			for _, dep := range node.dependsOn {
+				depChannel, ok := done[dep]
+				if !ok {
+					logger.Errorf("node %v depends on unknown node %v, running anyway", node.name, dep)
+					continue
+				}
+				<-depChannel
+			}
+
+			backoffSettings := wait.Backoff{
+				Duration: time.Second * 15,
+				Factor:   1.3,
+				Steps:    25,
+			}
+			attempts := 0
+			start := time.Now()
+			// ExponentialBackoffWithContext (unlike ExponentialBackoff) checks
+			// ctx.Done() between steps and returns ctx.Err() as soon as it
+			// does, so canceling ctx actually cuts a stuck retry loop short
+			// instead of sleeping through the rest of its up-to-25 steps.
+			err := wait.ExponentialBackoffWithContext(ctx, backoffSettings, func(ctx context.Context) (bool, error) {
+				attempts++
+				return node.fn(ctx, opts, filter, logger)
+			})
+			m := dagNodeMetrics{Name: node.name, Attempts: attempts, DurationMs: time.Since(start).Milliseconds()}
+			if err != nil {
+				m.Error = err.Error()
+			} else if tracker != nil {
+				tracker.markFinished(node.name)
+			}
+			metricsCh <- m
+			close(done[node.name])
+
+			if err != nil {
+				logger.Fatalf("Unrecoverable error/timed out in %v: %v", node.name, err)
+			}
+		}(node)
+	}
+
+	wg.Wait()
+	close(metricsCh)
+
+	metrics := make([]dagNodeMetrics, 0, len(nodes))
+	for m := range metricsCh {
+		metrics = append(metrics, m)
+	}
+	return metrics, nil
 }
 
 // filterObjects will do client-side filtering given an appropriately filled out
@@ -247,7 +1465,9 @@ func deleteServers(ctx context.Context, opts *clientconfig.ClientOpts, filter Fi
 	numberDeleted := 0
 	for _, server := range filteredServers {
 		logger.Debugf("Deleting Server %q", server.ID)
-		err = servers.Delete(ctx, conn, server.ID).ExtractErr()
+		err = planOrDelete(ctx, "Server", server.ID, "", server.Tags, func() error {
+			return servers.Delete(ctx, conn, server.ID).ExtractErr()
+		})
 		if err != nil {
 			// Ignore the error if the server cannot be found and return with an appropriate message if it's another type of error
 			if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
@@ -321,12 +1541,12 @@ func deleteServerGroups(ctx context.Context, opts *clientconfig.ClientOpts, filt
 	return numberDeleted == numberToDelete, nil
 }
 
-func deletePortsByNetwork(ctx context.Context, opts *clientconfig.ClientOpts, networkID string, logger logrus.FieldLogger) (bool, error) {
+func deletePortsByNetwork(ctx context.Context, opts *clientconfig.ClientOpts, networkID string, filter Filter, logger logrus.FieldLogger) (bool, error) {
 	listOpts := ports.ListOpts{
 		NetworkID: networkID,
 	}
 
-	result, err := deletePorts(ctx, opts, listOpts, logger)
+	result, err := deletePorts(ctx, opts, listOpts, filter, logger)
 	if err != nil {
 		logger.Error(err)
 		return false, nil
@@ -340,7 +1560,7 @@ func deletePortsByFilter(ctx context.Context, opts *clientconfig.ClientOpts, fil
 		TagsAny: strings.Join(tags, ","),
 	}
 
-	result, err := deletePorts(ctx, opts, listOpts, logger)
+	result, err := deletePorts(ctx, opts, listOpts, filter, logger)
 	if err != nil {
 		logger.Error(err)
 		return false, nil
@@ -390,7 +1610,50 @@ func getSGsByID(ctx context.Context, conn *gophercloud.ServiceClient, logger log
 	return sgByID, err
 }
 
-func deletePorts(ctx context.Context, opts *clientconfig.ClientOpts, listOpts ports.ListOpts, logger logrus.FieldLogger) (bool, error) {
+// discoverProviderSGsByAttachment finds load-balancer/cloud-provider-openstack
+// security groups by checking, for every SG known to exist, whether every
+// port it's attached to is one of the ports this destroy run is about to
+// delete. Unlike cloudProviderSGNameRegexp this doesn't depend on the
+// "lb-sg-<uuid>" naming convention, so it also catches groups that were
+// renamed by cloud-provider-openstack or customized by the operator.
+func discoverProviderSGsByAttachment(ctx context.Context, conn *gophercloud.ServiceClient, sgByID map[string]sg.SecGroup, clusterPortIDs map[string]bool, logger logrus.FieldLogger) map[string]bool {
+	deletable := make(map[string]bool)
+
+	allPages, err := ports.List(conn, ports.ListOpts{}).AllPages(ctx)
+	if err != nil {
+		logger.Error(err)
+		return deletable
+	}
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		logger.Error(err)
+		return deletable
+	}
+
+	attached := make(map[string]bool)
+	foreign := make(map[string]bool)
+	for _, port := range allPorts {
+		for _, groupID := range port.SecurityGroups {
+			if _, ok := sgByID[groupID]; !ok {
+				continue
+			}
+			attached[groupID] = true
+			if !clusterPortIDs[port.ID] {
+				foreign[groupID] = true
+			}
+		}
+	}
+
+	for groupID := range attached {
+		if !foreign[groupID] {
+			logger.Debugf("SG %q is only attached to cluster ports, treating it as a cloud-provider-openstack SG", groupID)
+			deletable[groupID] = true
+		}
+	}
+	return deletable
+}
+
+func deletePorts(ctx context.Context, opts *clientconfig.ClientOpts, listOpts ports.ListOpts, filter Filter, logger logrus.FieldLogger) (bool, error) {
 	logger.Debug("Deleting openstack ports")
 	defer logger.Debugf("Exiting deleting openstack ports")
 
@@ -427,57 +1690,70 @@ func deletePorts(ctx context.Context, opts *clientconfig.ClientOpts, listOpts po
 	}
 	cloudProviderSGNameRegexp := regexp.MustCompile(cloudProviderSGNamePattern)
 
+	clusterPortIDs := make(map[string]bool, len(allPorts))
+	for _, port := range allPorts {
+		clusterPortIDs[port.ID] = true
+	}
+	providerSGs := discoverProviderSGsByAttachment(ctx, conn, sgByID, clusterPortIDs, logger)
+
 	deletePortsWorker := func(portsChannel <-chan ports.Port, deletedChannel chan<- int) {
 		localDeleted := 0
 		for port := range portsChannel {
-			// If a user provisioned floating ip was used, it needs to be dissociated.
-			// Any floating Ip's associated with ports that are going to be deleted will be dissociated.
-			if fip, ok := fipByPort[port.ID]; ok {
-				logger.Debugf("Dissociating Floating IP %q", fip.ID)
-				_, err := floatingips.Update(ctx, conn, fip.ID, floatingips.UpdateOpts{}).Extract()
-				if err != nil {
-					// Ignore the error if the floating ip cannot be found and return with an appropriate message if it's another type of error
-					if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
-						// Just log the error and move on to the next port
-						logger.Errorf("While deleting port %q, the update of the floating IP %q failed with error: %v", port.ID, fip.ID, err)
-						continue
+			if _, ok := dryRunReportFromContext(ctx); !ok {
+				// If a user provisioned floating ip was used, it needs to be dissociated.
+				// Any floating Ip's associated with ports that are going to be deleted will be dissociated.
+				if fip, ok := fipByPort[port.ID]; ok {
+					logger.Debugf("Dissociating Floating IP %q", fip.ID)
+					_, err := floatingips.Update(ctx, conn, fip.ID, floatingips.UpdateOpts{}).Extract()
+					if err != nil {
+						// Ignore the error if the floating ip cannot be found and return with an appropriate message if it's another type of error
+						if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+							// Just log the error and move on to the next port
+							logger.Errorf("While deleting port %q, the update of the floating IP %q failed with error: %v", port.ID, fip.ID, err)
+							continue
+						}
+						logger.Debugf("Cannot find floating ip %q. It's probably already been deleted.", fip.ID)
 					}
-					logger.Debugf("Cannot find floating ip %q. It's probably already been deleted.", fip.ID)
 				}
-			}
 
-			// If there is a security group created by cloud-provider-openstack we should find it and delete it.
-			// We'll look through the ones on each of the ports and attempt to remove it from the port and delete it.
-			// Most of the time it's a conflict, but last port should be guaranteed to allow deletion.
-			// TODO(dulek): Currently this is the only way to do it and if delete fails there's no way to get back to
-			//              that SG. This is bad and we should make groups created by CPO tagged by cluster ID ASAP.
-			assignedSGs := port.SecurityGroups
-			ports.Update(ctx, conn, port.ID, ports.UpdateOpts{
-				SecurityGroups: &[]string{}, // We can just detach all, we're deleting this port anyway.
-			})
-			for _, groupID := range assignedSGs {
-				if group, ok := sgByID[groupID]; ok {
-					if cloudProviderSGNameRegexp.MatchString(group.Name) {
-						logger.Debugf("Deleting cloud-provider-openstack SG %q", groupID)
-						err := sg.Delete(ctx, conn, groupID).ExtractErr()
-						if err == nil || gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
-							// If SG is gone let's remove it from the map and it'll save us these calls later on.
-							delete(sgByID, groupID)
-						} else if !gophercloud.ResponseCodeIs(err, http.StatusConflict) { // Ignore 404 Not Found (clause before) and 409 Conflict
-							logger.Errorf("Deleting SG %q at port %q failed. SG might get orphaned: %v", groupID, port.ID, err)
+				// If there is a security group created by cloud-provider-openstack we should find it and delete it.
+				// We'll look through the ones on each of the ports and attempt to remove it from the port and delete it.
+				// Most of the time it's a conflict, but last port should be guaranteed to allow deletion.
+				// TODO(dulek): Currently this is the only way to do it and if delete fails there's no way to get back to
+				//              that SG. This is bad and we should make groups created by CPO tagged by cluster ID ASAP.
+				assignedSGs := port.SecurityGroups
+				ports.Update(ctx, conn, port.ID, ports.UpdateOpts{
+					SecurityGroups: &[]string{}, // We can just detach all, we're deleting this port anyway.
+				})
+				for _, groupID := range assignedSGs {
+					if group, ok := sgByID[groupID]; ok {
+						if providerSGs[groupID] || cloudProviderSGNameRegexp.MatchString(group.Name) {
+							logger.Debugf("Deleting cloud-provider-openstack SG %q", groupID)
+							err := sg.Delete(ctx, conn, groupID).ExtractErr()
+							if err == nil || gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+								// If SG is gone let's remove it from the map and it'll save us these calls later on.
+								delete(sgByID, groupID)
+							} else if !gophercloud.ResponseCodeIs(err, http.StatusConflict) { // Ignore 404 Not Found (clause before) and 409 Conflict
+								logger.Errorf("Deleting SG %q at port %q failed. SG might get orphaned: %v", groupID, port.ID, err)
+							}
 						}
 					}
 				}
+
+				// If this port is the parent of a trunk, the trunk and its subports
+				// must go first or the trunk will keep the port (and the subports'
+				// own ports) from being deleted.
+				deleteAssociatedTrunk(ctx, conn, filter, logger, port.ID)
 			}
 
 			logger.Debugf("Deleting Port %q", port.ID)
-			err = ports.Delete(ctx, conn, port.ID).ExtractErr()
+			err = planOrDelete(ctx, "Port", port.ID, port.Name, nil, func() error {
+				return ports.Delete(ctx, conn, port.ID).ExtractErr()
+			})
 			if err != nil {
 				// This can fail when port is still in use so return/retry
 				// Just log the error and move on to the next port
 				logger.Debugf("Deleting Port %q failed with error: %v", port.ID, err)
-				// Try to delete associated trunk
-				deleteAssociatedTrunk(ctx, conn, logger, port.ID)
 				continue
 			}
 			localDeleted++
@@ -546,7 +1822,9 @@ func deleteSecurityGroups(ctx context.Context, opts *clientconfig.ClientOpts, fi
 	numberDeleted := 0
 	for _, group := range allGroups {
 		logger.Debugf("Deleting Security Group: %q", group.ID)
-		err = sg.Delete(ctx, conn, group.ID).ExtractErr()
+		err = planOrDelete(ctx, "SecurityGroup", group.ID, group.Name, nil, func() error {
+			return sg.Delete(ctx, conn, group.ID).ExtractErr()
+		})
 		if err != nil {
 			// Ignore the error if the security group cannot be found
 			if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
@@ -657,53 +1935,56 @@ func deleteRouters(ctx context.Context, opts *clientconfig.ClientOpts, filter Fi
 	}
 
 	numberToDelete := len(allRouters)
-	numberDeleted := 0
+	jobs := make([]func() error, 0, len(allRouters))
 	for _, router := range allRouters {
-		fipOpts := floatingips.ListOpts{
-			RouterID: router.ID,
-		}
-
-		fipPages, err := floatingips.List(conn, fipOpts).AllPages(ctx)
-		if err != nil {
-			logger.Error(err)
-			return false, nil
-		}
+		router := router
+		jobs = append(jobs, func() error {
+			fipOpts := floatingips.ListOpts{
+				RouterID: router.ID,
+			}
 
-		allFIPs, err := floatingips.ExtractFloatingIPs(fipPages)
-		if err != nil {
-			logger.Error(err)
-			return false, nil
-		}
-		// If a user provisioned floating ip was used, it needs to be dissociated
-		// Any floating Ip's associated with routers that are going to be deleted will be dissociated
-		err = updateFips(ctx, allFIPs, opts, filter, logger)
-		if err != nil {
-			logger.Error(err)
-			continue
-		}
-		// Clean Gateway interface
-		updateOpts := routers.UpdateOpts{
-			GatewayInfo: &routers.GatewayInfo{},
-		}
+			fipPages, err := floatingips.List(conn, fipOpts).AllPages(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list floating IPs for router %q: %w", router.ID, err)
+			}
 
-		_, err = routers.Update(ctx, conn, router.ID, updateOpts).Extract()
-		if err != nil {
-			logger.Error(err)
-		}
+			allFIPs, err := floatingips.ExtractFloatingIPs(fipPages)
+			if err != nil {
+				return fmt.Errorf("failed to extract floating IPs for router %q: %w", router.ID, err)
+			}
+			if _, ok := dryRunReportFromContext(ctx); !ok {
+				// If a user provisioned floating ip was used, it needs to be dissociated
+				// Any floating Ip's associated with routers that are going to be deleted will be dissociated
+				if err := updateFips(ctx, allFIPs, opts, filter, logger); err != nil {
+					return fmt.Errorf("failed to dissociate floating IPs for router %q: %w", router.ID, err)
+				}
+				// Clean Gateway interface
+				updateOpts := routers.UpdateOpts{
+					GatewayInfo: &routers.GatewayInfo{},
+				}
 
-		logger.Debugf("Deleting Router %q", router.ID)
-		err = routers.Delete(ctx, conn, router.ID).ExtractErr()
-		if err != nil {
-			// Ignore the error if the router cannot be found and return with an appropriate message if it's another type of error
-			if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
-				// Just log the error and move on to the next router
-				logger.Errorf("Deleting router %q failed: %v", router.ID, err)
-				continue
+				if _, err := routers.Update(ctx, conn, router.ID, updateOpts).Extract(); err != nil {
+					logger.Error(err)
+				}
 			}
-			logger.Debugf("Cannot find router %q. It's probably already been deleted.", router.ID)
-		}
-		numberDeleted++
+
+			logger.Debugf("Deleting Router %q", router.ID)
+			return planOrDelete(ctx, "Router", router.ID, router.Name, nil, func() error {
+				err := routers.Delete(ctx, conn, router.ID).ExtractErr()
+				// Ignore the error if the router cannot be found
+				if err != nil && gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+					logger.Debugf("Cannot find router %q. It's probably already been deleted.", router.ID)
+					return nil
+				}
+				return err
+			})
+		})
+	}
+	numberDeleted, err := parallelDelete(ctx, "routers", jobs)
+	if err != nil {
+		logger.Debugf("Some routers could not be deleted: %v", err)
 	}
+
 	return numberDeleted == numberToDelete, nil
 }
 
@@ -839,53 +2120,107 @@ func removeRouterInterfaces(ctx context.Context, client *gophercloud.ServiceClie
 
 	clusterTag := "openshiftClusterID=" + filter["openshiftClusterID"]
 	clusterRouter := isClusterRouter(clusterTag, router.Tags)
+	policy := routerPolicyFromContext(ctx)
 
 	numberToDelete := len(allPorts)
-	numberDeleted := 0
-	var customInterfaces []ports.Port
-	// map to keep track of whether interface for subnet was already removed
+	var customInterfaces []customRouterInterface
+	// map to keep track of whether interface for subnet was already queued for removal
 	removedSubnets := make(map[string]bool)
+	var subnetsToRemove []string
 	for _, port := range allPorts {
 		for _, IP := range port.FixedIPs {
 			// Skip removal if Router was not created by CNO or installer and
-			// interface is not handled by the Cluster
-			if !clusterRouter && !isClusterSubnet(allSubnets, IP.SubnetID) {
+			// interface is not handled by the Cluster, unless RouterPolicy
+			// says to detach it anyway.
+			if !clusterRouter && !isClusterSubnet(allSubnets, IP.SubnetID) && policy != RouterPolicyDetachAll {
 				logger.Debugf("Found custom interface %q on Router %q", port.ID, router.ID)
-				customInterfaces = append(customInterfaces, port)
+				customInterfaces = append(customInterfaces, customRouterInterface{port: port, subnetID: IP.SubnetID})
 				continue
 			}
 			if !removedSubnets[IP.SubnetID] {
+				removedSubnets[IP.SubnetID] = true
+				subnetsToRemove = append(subnetsToRemove, IP.SubnetID)
+			}
+		}
+	}
+	numberToDelete -= len(customInterfaces)
+
+	jobs := make([]func() error, 0, len(subnetsToRemove))
+	for _, subnetID := range subnetsToRemove {
+		subnetID := subnetID
+		jobs = append(jobs, func() error {
+			logger.Debugf("Removing Subnet %q from Router %q", subnetID, router.ID)
+			return planOrDelete(ctx, "RouterInterface", subnetID, "", nil, func() error {
 				removeOpts := routers.RemoveInterfaceOpts{
-					SubnetID: IP.SubnetID,
+					SubnetID: subnetID,
 				}
-				logger.Debugf("Removing Subnet %q from Router %q", IP.SubnetID, router.ID)
 				_, err := routers.RemoveInterface(ctx, client, router.ID, removeOpts).Extract()
-				if err != nil {
-					if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
-						// This can fail when subnet is still in use
-						logger.Debugf("Removing Subnet %q from Router %q failed: %v", IP.SubnetID, router.ID, err)
-						return false, nil
-					}
-					logger.Debugf("Cannot find subnet %q. It's probably already been removed from router %q.", IP.SubnetID, router.ID)
+				// Ignore the error if the subnet's interface is already gone; this
+				// can also fail when the subnet is still in use, which parallelDelete
+				// reports as part of its aggregated error.
+				if err != nil && gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+					logger.Debugf("Cannot find subnet %q. It's probably already been removed from router %q.", subnetID, router.ID)
+					return nil
 				}
-				removedSubnets[IP.SubnetID] = true
-				numberDeleted++
-			}
+				return err
+			})
+		})
+	}
+	numberDeleted, err := parallelDelete(ctx, "routerinterfaces", jobs)
+	if err != nil {
+		logger.Debugf("Some interfaces could not be removed from router %q: %v", router.ID, err)
+	}
+
+	warnPreservedInterfaces(ctx, client, router, customInterfaces, logger)
+
+	if policy == RouterPolicyAdopt && !clusterRouter && len(customInterfaces) == 0 {
+		logger.Debugf("Adopting shared Router %q: every remaining interface belongs to this cluster", router.ID)
+		if err := planOrDelete(ctx, "Router", router.ID, router.Name, nil, func() error {
+			return routers.Delete(ctx, client, router.ID).ExtractErr()
+		}); err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			logger.Debugf("Adopting Router %q failed: %v", router.ID, err)
+			return false, nil
 		}
 	}
-	numberToDelete -= len(customInterfaces)
+
 	return numberToDelete == numberDeleted, nil
 }
 
-func isClusterRouter(clusterTag string, tags []string) bool {
-	for _, tag := range tags {
-		if clusterTag == tag {
+// customRouterInterface pairs a router port with the specific subnet on it
+// that isn't part of this cluster, so warnPreservedInterfaces can report the
+// subnet's CIDR without re-walking every port's FixedIPs.
+type customRouterInterface struct {
+	port     ports.Port
+	subnetID string
+}
+
+// warnPreservedInterfaces gives operators running BYON (bring-your-own-network)
+// installs an actionable summary of what RouterPolicyPreserveCustom left
+// attached, instead of the debug-only messages removeRouterInterfaces logs
+// while walking ports.
+func warnPreservedInterfaces(ctx context.Context, client *gophercloud.ServiceClient, router routers.Router, customInterfaces []customRouterInterface, logger logrus.FieldLogger) {
+	for _, ci := range customInterfaces {
+		cidr := ci.subnetID
+		if subnet, err := subnets.Get(ctx, client, ci.subnetID).Extract(); err == nil {
+			cidr = subnet.CIDR
+		}
+		logger.Warnf("Preserving custom interface %q: subnet %s (%s) on Router %q is not part of this cluster", ci.port.ID, ci.subnetID, cidr, router.Name)
+	}
+}
+
+func hasTag(tag string, tags []string) bool {
+	for _, t := range tags {
+		if tag == t {
 			return true
 		}
 	}
 	return false
 }
 
+func isClusterRouter(clusterTag string, tags []string) bool {
+	return hasTag(clusterTag, tags)
+}
+
 func deleteLeftoverLoadBalancers(ctx context.Context, opts *clientconfig.ClientOpts, logger logrus.FieldLogger, networkID string) error {
 	conn, err := openstackdefaults.NewServiceClient(ctx, "load-balancer", opts)
 	if err != nil {
@@ -987,21 +2322,30 @@ func deleteSubnets(ctx context.Context, opts *clientconfig.ClientOpts, filter Fi
 	}
 
 	numberToDelete := len(allSubnets)
-	numberDeleted := 0
+	jobs := make([]func() error, 0, len(allSubnets))
 	for _, subnet := range allSubnets {
-		logger.Debugf("Deleting Subnet: %q", subnet.ID)
-		err = subnets.Delete(ctx, conn, subnet.ID).ExtractErr()
-		if err != nil {
-			// Ignore the error if the subnet cannot be found
-			if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
-				// This can fail when subnet is still in use
-				// Just log the error and move on to the next subnet
-				logger.Debugf("Deleting Subnet %q failed: %v", subnet.ID, err)
-				continue
-			}
-			logger.Debugf("Cannot find subnet %q. It's probably already been deleted.", subnet.ID)
-		}
-		numberDeleted++
+		subnet := subnet
+		jobs = append(jobs, func() error {
+			return planOrDelete(ctx, "Subnet", subnet.ID, subnet.Name, nil, func() error {
+				logger.Debugf("Deleting Subnet: %q", subnet.ID)
+				err := subnets.Delete(ctx, conn, subnet.ID).ExtractErr()
+				if err != nil {
+					// Ignore the error if the subnet cannot be found
+					if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+						// This can fail when subnet is still in use
+						// Just log the error and move on to the next subnet
+						logger.Debugf("Deleting Subnet %q failed: %v", subnet.ID, err)
+						return err
+					}
+					logger.Debugf("Cannot find subnet %q. It's probably already been deleted.", subnet.ID)
+				}
+				return nil
+			})
+		})
+	}
+	numberDeleted, err := parallelDelete(ctx, "subnets", jobs)
+	if err != nil {
+		logger.Debugf("Some subnets could not be deleted: %v", err)
 	}
 	return numberDeleted == numberToDelete, nil
 }
@@ -1032,36 +2376,40 @@ func deleteNetworks(ctx context.Context, opts *clientconfig.ClientOpts, filter F
 		return false, nil
 	}
 	numberToDelete := len(allNetworks)
-	numberDeleted := 0
+	jobs := make([]func() error, 0, len(allNetworks))
 	for _, network := range allNetworks {
-		logger.Debugf("Deleting network: %q", network.ID)
-		err = networks.Delete(ctx, conn, network.ID).ExtractErr()
-		if err != nil {
-			// Ignore the error if the network cannot be found
-			if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
-				// This can fail when network is still in use. Let's log an error and try to fix this.
-				logger.Debugf("Deleting Network %q failed: %v", network.ID, err)
-
-				// First try to delete eventual leftover load balancers
-				// *This has to be done before attempt to remove ports or we'll delete LB ports!*
-				err := deleteLeftoverLoadBalancers(ctx, opts, logger, network.ID)
+		network := network
+		jobs = append(jobs, func() error {
+			return planOrDelete(ctx, "Network", network.ID, network.Name, nil, func() error {
+				logger.Debugf("Deleting network: %q", network.ID)
+				err := networks.Delete(ctx, conn, network.ID).ExtractErr()
 				if err != nil {
-					logger.Error(err)
-					// Do not attempt to delete ports on LB removal problem or we'll lose FIP associations!
-					continue
-				}
+					// Ignore the error if the network cannot be found
+					if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+						// This can fail when network is still in use. Let's log an error and try to fix this.
+						logger.Debugf("Deleting Network %q failed: %v", network.ID, err)
+
+						// First try to delete eventual leftover load balancers
+						// *This has to be done before attempt to remove ports or we'll delete LB ports!*
+						if err := deleteLeftoverLoadBalancers(ctx, opts, logger, network.ID); err != nil {
+							// Do not attempt to delete ports on LB removal problem or we'll lose FIP associations!
+							return err
+						}
 
-				// Only then try to remove all the ports it may still contain (untagged as well).
-				// *We cannot delete ports before LBs because we'll lose FIP associations!*
-				_, err = deletePortsByNetwork(ctx, opts, network.ID, logger)
-				if err != nil {
-					logger.Error(err)
+						// Only then try to remove all the ports it may still contain (untagged as well).
+						// *We cannot delete ports before LBs because we'll lose FIP associations!*
+						_, err := deletePortsByNetwork(ctx, opts, network.ID, filter, logger)
+						return err
+					}
+					logger.Debugf("Cannot find network %q. It's probably already been deleted.", network.ID)
 				}
-				continue
-			}
-			logger.Debugf("Cannot find network %q. It's probably already been deleted.", network.ID)
-		}
-		numberDeleted++
+				return nil
+			})
+		})
+	}
+	numberDeleted, err := parallelDelete(ctx, "networks", jobs)
+	if err != nil {
+		logger.Debugf("Some networks could not be deleted: %v", err)
 	}
 	return numberDeleted == numberToDelete, nil
 }
@@ -1123,29 +2471,36 @@ func deleteContainers(ctx context.Context, opts *clientconfig.ClientOpts, filter
 			// Openshiftclusterid in the X-Container-Meta- HEAD output
 			titlekey := strings.Title(strings.ToLower(key))
 			if metadata[titlekey] == val {
-				queue := newSemaphore(3)
-				errCh := make(chan error)
+				if report, ok := dryRunReportFromContext(ctx); ok {
+					report.add("Container", container, "", metadata)
+					break
+				}
+				// Page-sized bulk-delete jobs are submitted to the shared destroyPool
+				// instead of a local semaphore, so container teardown honors the same
+				// DestroyConcurrency and DestroyQPS limits as every other kind.
+				var jobs []func() error
 				err := objects.List(conn, container, nil).EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
 					objectsOnPage, err := objects.ExtractNames(page)
 					if err != nil {
 						return false, err
 					}
-					queue.Add(func() {
+					jobs = append(jobs, func() error {
 						for len(objectsOnPage) > 0 {
 							logger.Debugf("Initiating bulk deletion of %d objects in container %q", len(objectsOnPage), container)
 							resp, err := objects.BulkDelete(ctx, conn, container, objectsOnPage).Extract()
 							if err != nil {
-								errCh <- err
-								return
+								return err
 							}
 							if len(resp.Errors) > 0 {
 								// Convert resp.Errors to golang errors.
 								// Each error is represented by a list of 2 strings, where the first one
 								// is the object name, and the second one contains an error message.
+								var errs []error
 								for _, objectError := range resp.Errors {
-									errCh <- fmt.Errorf("cannot delete object %q: %s", objectError[0], objectError[1])
+									errs = append(errs, fmt.Errorf("cannot delete object %q: %s", objectError[0], objectError[1]))
 								}
 								logger.Debugf("Terminating object deletion routine with error. Deleted %d objects out of %d.", resp.NumberDeleted, len(objectsOnPage))
+								return k8serrors.NewAggregate(errs)
 							}
 
 							// Some object-storage instances may be set to have a limit to the LIST operation
@@ -1156,6 +2511,7 @@ func deleteContainers(ctx context.Context, opts *clientconfig.ClientOpts, filter
 							objectsOnPage = objectsOnPage[resp.NumberDeleted+resp.NumberNotFound:]
 						}
 						logger.Debugf("Terminating object deletion routine.")
+						return nil
 					})
 					return true, nil
 				})
@@ -1165,20 +2521,14 @@ func deleteContainers(ctx context.Context, opts *clientconfig.ClientOpts, filter
 						return false, nil
 					}
 				}
-				var errs []error
-				go func() {
-					for err := range errCh {
-						errs = append(errs, err)
-					}
-				}()
-
-				queue.Wait()
-				close(errCh)
-				if len(errs) > 0 {
-					return false, fmt.Errorf("errors occurred during bulk deletion of the objects of container %q: %w", container, k8serrors.NewAggregate(errs))
+				if _, err := parallelDelete(ctx, "containers", jobs); err != nil {
+					return false, fmt.Errorf("errors occurred during bulk deletion of the objects of container %q: %w", container, err)
 				}
 				logger.Debugf("Deleting container %q", container)
-				_, err = containers.Delete(ctx, conn, container).Extract()
+				err = planOrDelete(ctx, "Container", container, "", nil, func() error {
+					_, err := containers.Delete(ctx, conn, container).Extract()
+					return err
+				})
 				if err != nil {
 					// Ignore the error if the container cannot be found and return with an appropriate message if it's another type of error
 					if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
@@ -1225,10 +2575,102 @@ func deleteTrunks(ctx context.Context, opts *clientconfig.ClientOpts, filter Fil
 		return false, nil
 	}
 	numberToDelete := len(allTrunks)
-	numberDeleted := 0
+	jobs := make([]func() error, 0, len(allTrunks))
+	for _, trunk := range allTrunks {
+		trunk := trunk
+		jobs = append(jobs, func() error {
+			return planOrDelete(ctx, "Trunk", trunk.ID, trunk.Name, nil, func() error {
+				logger.Debugf("Deleting Trunk %q", trunk.ID)
+				err := trunks.Delete(ctx, conn, trunk.ID).ExtractErr()
+				if err != nil {
+					// Ignore the error if the trunk cannot be found
+					if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+						// This can fail when the trunk is still in use so return/retry
+						// Just log the error and move on to the next trunk
+						logger.Debugf("Deleting Trunk %q failed: %v", trunk.ID, err)
+						return err
+					}
+					logger.Debugf("Cannot find trunk %q. It's probably already been deleted.", trunk.ID)
+				}
+				return nil
+			})
+		})
+	}
+	numberDeleted, err := parallelDelete(ctx, "trunks", jobs)
+	if err != nil {
+		logger.Debugf("Some trunks could not be deleted: %v", err)
+	}
+	return numberDeleted == numberToDelete, nil
+}
+
+// purgeTrunkSubports looks up any trunk whose parent port is portID and, before
+// the parent port or the trunk itself can be deleted, unplugs its subports and
+// removes the subports' underlying ports when they're tagged with the cluster
+// identifier. This mirrors the CAPI-OpenStack fix for orphaned subports
+// blocking cluster deletion: leaving them plugged in prevents both the trunk
+// and the parent port from being deleted.
+func purgeTrunkSubports(ctx context.Context, conn *gophercloud.ServiceClient, filter Filter, logger logrus.FieldLogger, portID string) []trunks.Trunk {
+	listOpts := trunks.ListOpts{
+		PortID: portID,
+	}
+	allPages, err := trunks.List(conn, listOpts).AllPages(ctx)
+	if err != nil {
+		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			logger.Debug("Skip trunk lookup because the cloud doesn't support trunk ports")
+			return nil
+		}
+		logger.Error(err)
+		return nil
+	}
+
+	allTrunks, err := trunks.ExtractTrunks(allPages)
+	if err != nil {
+		logger.Error(err)
+		return nil
+	}
+
+	clusterTag := "openshiftClusterID=" + filter["openshiftClusterID"]
+	for _, trunk := range allTrunks {
+		if len(trunk.Subports) == 0 {
+			continue
+		}
+
+		logger.Debugf("Removing %d subports from Trunk %q", len(trunk.Subports), trunk.ID)
+		_, err := trunks.RemoveSubports(ctx, conn, trunk.ID, trunks.RemoveSubportsOpts{Subports: trunk.Subports}).Extract()
+		if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			logger.Errorf("Removing subports from Trunk %q failed: %v", trunk.ID, err)
+			continue
+		}
+
+		for _, subport := range trunk.Subports {
+			tags, err := attributestags.List(ctx, conn, "ports", subport.PortID).Extract()
+			if err != nil {
+				if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+					logger.Errorf("Listing tags for subport %q failed: %v", subport.PortID, err)
+				}
+				continue
+			}
+			if !hasTag(clusterTag, tags) {
+				logger.Debugf("Leaving subport %q alone, it's not tagged with %q", subport.PortID, clusterTag)
+				continue
+			}
+			logger.Debugf("Deleting subport %q", subport.PortID)
+			if err := ports.Delete(ctx, conn, subport.PortID).ExtractErr(); err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				logger.Errorf("Deleting subport %q failed: %v", subport.PortID, err)
+			}
+		}
+	}
+	return allTrunks
+}
+
+func deleteAssociatedTrunk(ctx context.Context, conn *gophercloud.ServiceClient, filter Filter, logger logrus.FieldLogger, portID string) {
+	logger.Debug("Deleting associated trunk")
+	defer logger.Debugf("Exiting deleting associated trunk")
+
+	allTrunks := purgeTrunkSubports(ctx, conn, filter, logger, portID)
 	for _, trunk := range allTrunks {
 		logger.Debugf("Deleting Trunk %q", trunk.ID)
-		err = trunks.Delete(ctx, conn, trunk.ID).ExtractErr()
+		err := trunks.Delete(ctx, conn, trunk.ID).ExtractErr()
 		if err != nil {
 			// Ignore the error if the trunk cannot be found
 			if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
@@ -1239,111 +2681,299 @@ func deleteTrunks(ctx context.Context, opts *clientconfig.ClientOpts, filter Fil
 			}
 			logger.Debugf("Cannot find trunk %q. It's probably already been deleted.", trunk.ID)
 		}
-		numberDeleted++
 	}
-	return numberDeleted == numberToDelete, nil
 }
 
-func deleteAssociatedTrunk(ctx context.Context, conn *gophercloud.ServiceClient, logger logrus.FieldLogger, portID string) {
-	logger.Debug("Deleting associated trunk")
-	defer logger.Debugf("Exiting deleting associated trunk")
+func deleteLoadBalancers(ctx context.Context, opts *clientconfig.ClientOpts, filter Filter, logger logrus.FieldLogger) (bool, error) {
+	logger.Debug("Deleting openstack load balancers")
+	defer logger.Debugf("Exiting deleting openstack load balancers")
 
-	listOpts := trunks.ListOpts{
-		PortID: portID,
+	conn, err := openstackdefaults.NewServiceClient(ctx, "load-balancer", opts)
+	if err != nil {
+		// Ignore the error if Octavia is not available for the cloud
+		var gerr *gophercloud.ErrEndpointNotFound
+		if errors.As(err, &gerr) {
+			logger.Debug("Skip load balancer deletion because Octavia endpoint is not found")
+			return true, nil
+		}
+		logger.Error(err)
+		return false, nil
 	}
-	allPages, err := trunks.List(conn, listOpts).AllPages(ctx)
+
+	// Don't use tags for checking loadbalancers, as tags are useless here -
+	// the only one which was created is:
+	// kube_service_CLUSTERID_NAMESPACE_SERVICENAME
+	// which basically is the same as the loadbalancer name. More reliable
+	// approach would be to check either description or name of the OpenStack
+	// LB resource and check whether it contain clusterID string.
+	clusterID := filter["openshiftClusterID"]
+	var allLoadBalancersToRemove []loadbalancers.LoadBalancer
+	listOpts := loadbalancers.ListOpts{}
+
+	allPages, err := loadbalancers.List(conn, listOpts).AllPages(ctx)
 	if err != nil {
-		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
-			logger.Debug("Skip trunk deletion because the cloud doesn't support trunk ports")
-			return
+		logger.Error(err)
+		return false, nil
+	}
+
+	allLoadBalancers, err := loadbalancers.ExtractLoadBalancers(allPages)
+	if err != nil {
+		logger.Error(err)
+		return false, nil
+	}
+
+	for _, lb := range allLoadBalancers {
+		if strings.Contains(lb.Description, clusterID) {
+			allLoadBalancersToRemove = append(allLoadBalancersToRemove, lb)
+		}
+	}
+
+	deleteOpts := loadbalancers.DeleteOpts{
+		Cascade: true,
+	}
+	numberToDelete := len(allLoadBalancersToRemove)
+	jobs := make([]func() error, 0, len(allLoadBalancersToRemove))
+	for _, loadbalancer := range allLoadBalancersToRemove {
+		loadbalancer := loadbalancer
+		jobs = append(jobs, func() error {
+			return planOrDelete(ctx, "LoadBalancer", loadbalancer.ID, loadbalancer.Name, nil, func() error {
+				logger.Debugf("Deleting LoadBalancer %q", loadbalancer.ID)
+				err := loadbalancers.Delete(ctx, conn, loadbalancer.ID, deleteOpts).ExtractErr()
+				if err != nil {
+					// Ignore the error if the load balancer cannot be found
+					if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+						logger.Debugf("Cannot find load balancer %q. It's probably already been deleted.", loadbalancer.ID)
+						return nil
+					}
+					// Some Octavia deployments disable cascading deletes. Fall back to
+					// tearing down the listener/pool/health-monitor hierarchy by hand.
+					if gophercloud.ResponseCodeIs(err, http.StatusBadRequest) {
+						logger.Debugf("Cascade delete of load balancer %q failed, falling back to manual teardown: %v", loadbalancer.ID, err)
+						if err := manualDeleteLoadBalancer(ctx, conn, loadbalancer.ID, logger); err != nil {
+							logger.Debugf("Manual teardown of load balancer %q failed: %v", loadbalancer.ID, err)
+							return err
+						}
+						return pollLoadBalancerDeleted(ctx, conn, loadbalancer.ID, logger)
+					}
+					// This can fail when the load balancer is still in use so return/retry
+					// Just log the error and move on to the next load balancer
+					logger.Debugf("Deleting load balancer %q failed: %v", loadbalancer.ID, err)
+					return err
+				}
+				// cleanVIPsPorts and deleteFloatingIPs run right after this node
+				// in the DAG and need the VIP port Octavia was holding onto to
+				// actually be free, so wait for the load balancer to finish
+				// tearing down instead of moving on as soon as Delete is accepted.
+				return pollLoadBalancerDeleted(ctx, conn, loadbalancer.ID, logger)
+			})
+		})
+	}
+	numberDeleted, err := parallelDelete(ctx, "loadbalancers", jobs)
+	if err != nil {
+		logger.Debugf("Some load balancers could not be deleted: %v", err)
+	}
+
+	return numberDeleted == numberToDelete, nil
+}
+
+// manualDeleteLoadBalancer tears down an Octavia load balancer's listener,
+// pool and health-monitor hierarchy by hand, then deletes the load balancer
+// itself, for clouds where cascading delete isn't available.
+func manualDeleteLoadBalancer(ctx context.Context, conn *gophercloud.ServiceClient, loadBalancerID string, logger logrus.FieldLogger) error {
+	allPages, err := listeners.List(conn, listeners.ListOpts{LoadbalancerID: loadBalancerID}).AllPages(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list listeners for load balancer %q: %w", loadBalancerID, err)
+	}
+	allListeners, err := listeners.ExtractListeners(allPages)
+	if err != nil {
+		return fmt.Errorf("failed to extract listeners for load balancer %q: %w", loadBalancerID, err)
+	}
+
+	for _, listener := range allListeners {
+		poolPages, err := pools.List(conn, pools.ListOpts{LoadbalancerID: loadBalancerID, ListenerID: listener.ID}).AllPages(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list pools for listener %q: %w", listener.ID, err)
+		}
+		allPools, err := pools.ExtractPools(poolPages)
+		if err != nil {
+			return fmt.Errorf("failed to extract pools for listener %q: %w", listener.ID, err)
+		}
+
+		for _, pool := range allPools {
+			monitorPages, err := monitors.List(conn, monitors.ListOpts{PoolID: pool.ID}).AllPages(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list health monitors for pool %q: %w", pool.ID, err)
+			}
+			allMonitors, err := monitors.ExtractMonitors(monitorPages)
+			if err != nil {
+				return fmt.Errorf("failed to extract health monitors for pool %q: %w", pool.ID, err)
+			}
+			for _, monitor := range allMonitors {
+				logger.Debugf("Deleting health monitor %q", monitor.ID)
+				if err := monitors.Delete(ctx, conn, monitor.ID).ExtractErr(); err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+					return fmt.Errorf("failed to delete health monitor %q: %w", monitor.ID, err)
+				}
+			}
+
+			logger.Debugf("Deleting pool %q", pool.ID)
+			if err := pools.Delete(ctx, conn, pool.ID).ExtractErr(); err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return fmt.Errorf("failed to delete pool %q: %w", pool.ID, err)
+			}
+		}
+
+		logger.Debugf("Deleting listener %q", listener.ID)
+		if err := listeners.Delete(ctx, conn, listener.ID).ExtractErr(); err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return fmt.Errorf("failed to delete listener %q: %w", listener.ID, err)
 		}
-		logger.Error(err)
-		return
 	}
 
-	allTrunks, err := trunks.ExtractTrunks(allPages)
-	if err != nil {
-		logger.Error(err)
-		return
+	logger.Debugf("Deleting LoadBalancer %q", loadBalancerID)
+	err = loadbalancers.Delete(ctx, conn, loadBalancerID, loadbalancers.DeleteOpts{}).ExtractErr()
+	if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return fmt.Errorf("failed to delete load balancer %q: %w", loadBalancerID, err)
 	}
-	for _, trunk := range allTrunks {
-		logger.Debugf("Deleting Trunk %q", trunk.ID)
-		err = trunks.Delete(ctx, conn, trunk.ID).ExtractErr()
+	return nil
+}
+
+// pollLoadBalancerDeleted waits for the Octavia load balancer id to reach
+// provisioning_status DELETED, or disappear outright (404), before returning.
+// Both the cascade-delete and manual-teardown paths accept the delete
+// asynchronously, and the VIP port/FIP they were holding isn't actually free
+// until Octavia finishes tearing the load balancer down.
+func pollLoadBalancerDeleted(ctx context.Context, conn *gophercloud.ServiceClient, id string, logger logrus.FieldLogger) error {
+	backoffSettings := wait.Backoff{
+		Duration: 5 * time.Second,
+		Factor:   1.5,
+		Steps:    15,
+	}
+	// ExponentialBackoffWithContext (unlike ExponentialBackoff) checks
+	// ctx.Done() between steps and returns ctx.Err() as soon as it does, so a
+	// second SIGINT/SIGTERM actually cuts this poll short instead of sleeping
+	// through the rest of its up-to-15 steps.
+	return wait.ExponentialBackoffWithContext(ctx, backoffSettings, func(ctx context.Context) (bool, error) {
+		lb, err := loadbalancers.Get(ctx, conn, id).Extract()
 		if err != nil {
-			// Ignore the error if the trunk cannot be found
-			if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
-				// This can fail when the trunk is still in use so return/retry
-				// Just log the error and move on to the next trunk
-				logger.Debugf("Deleting Trunk %q failed: %v", trunk.ID, err)
-				continue
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return true, nil
 			}
-			logger.Debugf("Cannot find trunk %q. It's probably already been deleted.", trunk.ID)
+			return false, err
+		}
+		if lb.ProvisioningStatus == "DELETED" {
+			return true, nil
 		}
+		logger.Debugf("load balancer %q still %v, waiting for delete to finish", id, lb.ProvisioningStatus)
+		return false, nil
+	})
+}
+
+// amphoraSGNamePrefix is how cloud-provider-openstack and Octavia itself name
+// the security group that protects the amphora management network.
+const amphoraSGNamePrefix = "lb-mgmt-"
+
+// amphoraResourceProjectID returns the project ID this destroy run's own
+// token is scoped to, so reapAmphoraResources can limit its amphora
+// port/SG listing to that project instead of walking every project on a
+// shared cloud. Returns "" when the auth method can't expose a project,
+// same fallback validateProjectScope uses for the same extraction.
+func amphoraResourceProjectID(conn *gophercloud.ServiceClient, logger logrus.FieldLogger) string {
+	projectExtractor, ok := conn.ProviderClient.GetAuthResult().(interface {
+		ExtractProject() (*tokens.Project, error)
+	})
+	if !ok {
+		logger.Debug("Cannot determine the authenticated token's project scope for this auth method")
+		return ""
+	}
+	project, err := projectExtractor.ExtractProject()
+	if err != nil {
+		logger.Debugf("Failed to extract the authenticated token's project: %v", err)
+		return ""
 	}
-	return
+	return project.ID
 }
 
-func deleteLoadBalancers(ctx context.Context, opts *clientconfig.ClientOpts, filter Filter, logger logrus.FieldLogger) (bool, error) {
-	logger.Debug("Deleting openstack load balancers")
-	defer logger.Debugf("Exiting deleting openstack load balancers")
+// reapAmphoraResources cleans up the Octavia amphora-side resources that
+// deleteLoadBalancers doesn't know about: the amphora management ports
+// (device_owner prefixed with "Octavia:") and the amphora management
+// security groups. Left behind, these block network and subnet deletion.
+//
+// Amphora management ports/SGs aren't tagged with the cluster's InfraID, so
+// unlike the rest of this file's delete* functions this can't filter by tag
+// or name. The best available scoping is the destroy run's own authenticated
+// project: list only within that project rather than tenant-wide, so this
+// never touches another project's (or, on a cloud shared by multiple
+// clusters in one project, another cluster's) amphora-mgmt ports and SGs.
+func reapAmphoraResources(ctx context.Context, opts *clientconfig.ClientOpts, filter Filter, logger logrus.FieldLogger) (bool, error) {
+	logger.Debug("Reaping Octavia amphora-side resources")
+	defer logger.Debugf("Exiting reaping Octavia amphora-side resources")
 
-	conn, err := openstackdefaults.NewServiceClient(ctx, "load-balancer", opts)
+	conn, err := openstackdefaults.NewServiceClient(ctx, "network", opts)
 	if err != nil {
-		// Ignore the error if Octavia is not available for the cloud
-		var gerr *gophercloud.ErrEndpointNotFound
-		if errors.As(err, &gerr) {
-			logger.Debug("Skip load balancer deletion because Octavia endpoint is not found")
-			return true, nil
-		}
 		logger.Error(err)
 		return false, nil
 	}
 
-	// Don't use tags for checking loadbalancers, as tags are useless here -
-	// the only one which was created is:
-	// kube_service_CLUSTERID_NAMESPACE_SERVICENAME
-	// which basically is the same as the loadbalancer name. More reliable
-	// approach would be to check either description or name of the OpenStack
-	// LB resource and check whether it contain clusterID string.
-	clusterID := filter["openshiftClusterID"]
-	var allLoadBalancersToRemove []loadbalancers.LoadBalancer
-	listOpts := loadbalancers.ListOpts{}
+	projectID := amphoraResourceProjectID(conn, logger)
+	if projectID == "" {
+		logger.Warn("Could not determine this destroy run's own project scope; skipping amphora management port/SG reaping rather than risk deleting another project's Octavia resources")
+		return true, nil
+	}
 
-	allPages, err := loadbalancers.List(conn, listOpts).AllPages(ctx)
+	allPages, err := ports.List(conn, ports.ListOpts{ProjectID: projectID}).AllPages(ctx)
 	if err != nil {
 		logger.Error(err)
 		return false, nil
 	}
-
-	allLoadBalancers, err := loadbalancers.ExtractLoadBalancers(allPages)
+	allPorts, err := ports.ExtractPorts(allPages)
 	if err != nil {
 		logger.Error(err)
 		return false, nil
 	}
 
-	for _, lb := range allLoadBalancers {
-		if strings.Contains(lb.Description, clusterID) {
-			allLoadBalancersToRemove = append(allLoadBalancersToRemove, lb)
+	amphoraPorts := make([]ports.Port, 0)
+	for _, port := range allPorts {
+		if strings.HasPrefix(port.DeviceOwner, "Octavia:") {
+			amphoraPorts = append(amphoraPorts, port)
 		}
 	}
 
-	deleteOpts := loadbalancers.DeleteOpts{
-		Cascade: true,
+	sgPages, err := sg.List(conn, sg.ListOpts{ProjectID: projectID}).AllPages(ctx)
+	if err != nil {
+		logger.Error(err)
+		return false, nil
 	}
-	numberToDelete := len(allLoadBalancersToRemove)
+	allGroups, err := sg.ExtractGroups(sgPages)
+	if err != nil {
+		logger.Error(err)
+		return false, nil
+	}
+	amphoraSGs := make([]sg.SecGroup, 0)
+	for _, group := range allGroups {
+		if strings.HasPrefix(group.Name, amphoraSGNamePrefix) {
+			amphoraSGs = append(amphoraSGs, group)
+		}
+	}
+
+	numberToDelete := len(amphoraPorts) + len(amphoraSGs)
 	numberDeleted := 0
-	for _, loadbalancer := range allLoadBalancersToRemove {
-		logger.Debugf("Deleting LoadBalancer %q", loadbalancer.ID)
-		err = loadbalancers.Delete(ctx, conn, loadbalancer.ID, deleteOpts).ExtractErr()
-		if err != nil {
-			// Ignore the error if the load balancer cannot be found
-			if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
-				// This can fail when the load balancer is still in use so return/retry
-				// Just log the error and move on to the next port
-				logger.Debugf("Deleting load balancer %q failed: %v", loadbalancer.ID, err)
-				continue
-			}
-			logger.Debugf("Cannot find load balancer %q. It's probably already been deleted.", loadbalancer.ID)
+	for _, port := range amphoraPorts {
+		logger.Debugf("Deleting amphora management Port %q", port.ID)
+		err := planOrDelete(ctx, "Port", port.ID, port.Name, nil, func() error {
+			return ports.Delete(ctx, conn, port.ID).ExtractErr()
+		})
+		if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			logger.Debugf("Deleting amphora management port %q failed: %v", port.ID, err)
+			continue
+		}
+		numberDeleted++
+	}
+	for _, group := range amphoraSGs {
+		logger.Debugf("Deleting amphora management Security Group %q", group.ID)
+		err := planOrDelete(ctx, "SecurityGroup", group.ID, group.Name, nil, func() error {
+			return sg.Delete(ctx, conn, group.ID).ExtractErr()
+		})
+		if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			logger.Debugf("Deleting amphora management security group %q failed: %v", group.ID, err)
+			continue
 		}
 		numberDeleted++
 	}
@@ -1402,20 +3032,29 @@ func deleteVolumes(ctx context.Context, opts *clientconfig.ClientOpts, filter Fi
 	}
 
 	numberToDelete := len(volumeIDs)
-	numberDeleted := 0
+	jobs := make([]func() error, 0, len(volumeIDs))
 	for _, volumeID := range volumeIDs {
-		logger.Debugf("Deleting volume %q", volumeID)
-		err = volumes.Delete(ctx, conn, volumeID, deleteOpts).ExtractErr()
-		if err != nil {
-			// Ignore the error if the volume cannot be found
-			if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
-				// Just log the error and move on to the next volume
-				logger.Debugf("Deleting volume %q failed: %v", volumeID, err)
-				continue
-			}
-			logger.Debugf("Cannot find volume %q. It's probably already been deleted.", volumeID)
-		}
-		numberDeleted++
+		volumeID := volumeID
+		jobs = append(jobs, func() error {
+			return planOrDelete(ctx, "Volume", volumeID, "", nil, func() error {
+				logger.Debugf("Deleting volume %q", volumeID)
+				err := volumes.Delete(ctx, conn, volumeID, deleteOpts).ExtractErr()
+				if err != nil {
+					// Ignore the error if the volume cannot be found
+					if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+						// Just log the error and move on to the next volume
+						logger.Debugf("Deleting volume %q failed: %v", volumeID, err)
+						return err
+					}
+					logger.Debugf("Cannot find volume %q. It's probably already been deleted.", volumeID)
+				}
+				return nil
+			})
+		})
+	}
+	numberDeleted, err := parallelDelete(ctx, "volumes", jobs)
+	if err != nil {
+		logger.Debugf("Some volumes could not be deleted: %v", err)
 	}
 
 	return numberDeleted == numberToDelete, nil
@@ -1453,29 +3092,127 @@ func deleteVolumeSnapshots(ctx context.Context, opts *clientconfig.ClientOpts, f
 		return false, nil
 	}
 
-	numberToDelete := len(allSnapshots)
-	numberDeleted := 0
+	var toDelete []snapshots.Snapshot
 	for _, snapshot := range allSnapshots {
 		// Delete only those snapshots that contain cluster ID in the metadata
 		if val, ok := snapshot.Metadata[cinderCSIClusterIDKey]; ok && val == clusterID {
+			toDelete = append(toDelete, snapshot)
+		}
+	}
+
+	numberToDelete := len(toDelete)
+	jobs := make([]func() error, 0, len(toDelete))
+	for _, snapshot := range toDelete {
+		snapshot := snapshot
+		jobs = append(jobs, func() error {
 			logger.Debugf("Deleting volume snapshot %q", snapshot.ID)
-			err = snapshots.Delete(ctx, conn, snapshot.ID).ExtractErr()
+			err := planOrDelete(ctx, "VolumeSnapshot", snapshot.ID, snapshot.Name, snapshot.Metadata, func() error {
+				return snapshots.Delete(ctx, conn, snapshot.ID).ExtractErr()
+			})
 			if err != nil {
-				// Ignore the error if the server cannot be found
-				if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
-					// Just log the error and move on to the next volume snapshot
-					logger.Debugf("Deleting volume snapshot %q failed: %v", snapshot.ID, err)
-					continue
+				// Ignore the error if the snapshot cannot be found
+				if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+					logger.Debugf("Cannot find volume snapshot %q. It's probably already been deleted.", snapshot.ID)
+					return nil
 				}
-				logger.Debugf("Cannot find volume snapshot %q. It's probably already been deleted.", snapshot.ID)
+				if forceFromContext(ctx) {
+					if err := forceDeleteVolumeSnapshot(ctx, conn, snapshot.ID, logger); err != nil {
+						return fmt.Errorf("force-deleting volume snapshot %q failed: %w", snapshot.ID, err)
+					}
+					return nil
+				}
+				// The most common cause is a snapshot stuck in error_deleting,
+				// which Force can clear.
+				return fmt.Errorf("deleting volume snapshot %q failed: %w (re-run with Force set against an admin cloud to clear a stuck snapshot)", snapshot.ID, err)
 			}
-		}
-		numberDeleted++
+			return nil
+		})
+	}
+	numberDeleted, err := parallelDelete(ctx, "volumesnapshots", jobs)
+	if err != nil {
+		logger.Debugf("Some volume snapshots could not be deleted: %v", err)
 	}
 
 	return numberDeleted == numberToDelete, nil
 }
 
+// resourceAction POSTs an admin action body to <resource>/<id>/action, the
+// shape Manila and Cinder both use for reset_status/force_delete/etc. A 403
+// almost always means the token lacks the admin role these actions require,
+// so that case gets a message telling the operator to re-run with an admin
+// cloud instead of the bare API error.
+func resourceAction(ctx context.Context, conn *gophercloud.ServiceClient, resource, id, action string, body map[string]interface{}) error {
+	url := conn.ServiceURL(resource, id, "action")
+	_, err := conn.Post(ctx, url, map[string]interface{}{action: body}, nil, &gophercloud.RequestOpts{OkCodes: []int{200, 202}})
+	if err != nil && gophercloud.ResponseCodeIs(err, http.StatusForbidden) {
+		return fmt.Errorf("%s on %s %q requires the admin role; re-run destroy against an admin cloud: %w", action, resource, id, err)
+	}
+	return err
+}
+
+// forceDeleteShare is the Force fallback for a Manila share stuck in
+// error_deleting: deny every access rule manila recorded for it (a common
+// cause of a share refusing to leave its mount points), reset its status to
+// available via the admin reset_status action, then retry the plain Delete
+// the caller already attempted.
+func forceDeleteShare(ctx context.Context, conn *gophercloud.ServiceClient, shareID string, logger logrus.FieldLogger) error {
+	allPages, err := shares.ListAccessRights(conn, shareID).AllPages(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list access rights for share %q: %w", shareID, err)
+	}
+	accessRights, err := shares.ExtractAccessRights(allPages)
+	if err != nil {
+		return fmt.Errorf("failed to extract access rights for share %q: %w", shareID, err)
+	}
+	for _, rule := range accessRights {
+		logger.Debugf("Denying access rule %q on share %q", rule.ID, shareID)
+		if err := shares.DenyAccess(ctx, conn, shareID, shares.DenyAccessOpts{AccessID: rule.ID}).ExtractErr(); err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return fmt.Errorf("failed to deny access rule %q on share %q: %w", rule.ID, shareID, err)
+		}
+	}
+
+	if err := resourceAction(ctx, conn, "shares", shareID, "reset_status", map[string]interface{}{"status": "available"}); err != nil {
+		return err
+	}
+
+	logger.Debugf("Retrying delete of share %q after reset_status", shareID)
+	if err := shares.Delete(ctx, conn, shareID).ExtractErr(); err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return fmt.Errorf("delete of share %q still failed after reset_status: %w", shareID, err)
+	}
+	return nil
+}
+
+// forceDeleteShareSnapshot is the Force fallback for a Manila share
+// snapshot stuck in error_deleting: reset its status to available via the
+// admin reset_status action, then retry Delete.
+func forceDeleteShareSnapshot(ctx context.Context, conn *gophercloud.ServiceClient, snapshotID string, logger logrus.FieldLogger) error {
+	if err := resourceAction(ctx, conn, "snapshots", snapshotID, "reset_status", map[string]interface{}{"status": "available"}); err != nil {
+		return err
+	}
+
+	logger.Debugf("Retrying delete of share snapshot %q after reset_status", snapshotID)
+	if err := sharesnapshots.Delete(ctx, conn, snapshotID).ExtractErr(); err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return fmt.Errorf("delete of share snapshot %q still failed after reset_status: %w", snapshotID, err)
+	}
+	return nil
+}
+
+// forceDeleteVolumeSnapshot is the Force fallback for a Cinder snapshot
+// stuck in error_deleting: reset its status to available via the admin
+// os-reset_status action, then force_delete it, which bypasses the status
+// check a plain Delete enforces.
+func forceDeleteVolumeSnapshot(ctx context.Context, conn *gophercloud.ServiceClient, snapshotID string, logger logrus.FieldLogger) error {
+	if err := resourceAction(ctx, conn, "snapshots", snapshotID, "os-reset_status", map[string]interface{}{"status": "available"}); err != nil {
+		return err
+	}
+
+	logger.Debugf("Force-deleting volume snapshot %q after os-reset_status", snapshotID)
+	if err := resourceAction(ctx, conn, "snapshots", snapshotID, "os-force_delete", map[string]interface{}{}); err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return fmt.Errorf("os-force_delete of volume snapshot %q failed: %w", snapshotID, err)
+	}
+	return nil
+}
+
 func deleteShares(ctx context.Context, opts *clientconfig.ClientOpts, filter Filter, logger logrus.FieldLogger) (bool, error) {
 	logger.Debug("Deleting OpenStack shares")
 	defer logger.Debugf("Exiting deleting OpenStack shares")
@@ -1517,28 +3254,44 @@ func deleteShares(ctx context.Context, opts *clientconfig.ClientOpts, filter Fil
 	}
 
 	numberToDelete := len(allShares)
-	numberDeleted := 0
+	jobs := make([]func() error, 0, len(allShares))
 	for _, share := range allShares {
-		deleted, err := deleteShareSnapshots(ctx, conn, share.ID, logger)
-		if err != nil {
-			return false, err
-		}
-		if !deleted {
-			return false, nil
-		}
+		share := share
+		jobs = append(jobs, func() error {
+			deleted, err := deleteShareSnapshots(ctx, conn, share.ID, logger)
+			if err != nil {
+				return err
+			}
+			if !deleted {
+				return fmt.Errorf("not all snapshots for share %q were deleted", share.ID)
+			}
 
-		logger.Debugf("Deleting share %q", share.ID)
-		err = shares.Delete(ctx, conn, share.ID).ExtractErr()
-		if err != nil {
-			// Ignore the error if the share cannot be found
-			if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
-				// Just log the error and move on to the next share
-				logger.Debugf("Deleting share %q failed: %v", share.ID, err)
-				continue
+			logger.Debugf("Deleting share %q", share.ID)
+			err = planOrDelete(ctx, "Share", share.ID, share.Name, nil, func() error {
+				return shares.Delete(ctx, conn, share.ID).ExtractErr()
+			})
+			if err != nil {
+				// Ignore the error if the share cannot be found
+				if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+					logger.Debugf("Cannot find share %q. It's probably already been deleted.", share.ID)
+					return nil
+				}
+				if forceFromContext(ctx) {
+					if err := forceDeleteShare(ctx, conn, share.ID, logger); err != nil {
+						return fmt.Errorf("force-deleting share %q failed: %w", share.ID, err)
+					}
+					return nil
+				}
+				// The most common cause is a share stuck in error_deleting
+				// with lingering access rules, which Force can clear.
+				return fmt.Errorf("deleting share %q failed: %w (re-run with Force set against an admin cloud to clear a stuck share)", share.ID, err)
 			}
-			logger.Debugf("Cannot find share %q. It's probably already been deleted.", share.ID)
-		}
-		numberDeleted++
+			return nil
+		})
+	}
+	numberDeleted, err := parallelDelete(ctx, "shares", jobs)
+	if err != nil {
+		logger.Debugf("Some shares could not be deleted: %v", err)
 	}
 
 	return numberDeleted == numberToDelete, nil
@@ -1565,20 +3318,34 @@ func deleteShareSnapshots(ctx context.Context, conn *gophercloud.ServiceClient,
 	}
 
 	numberToDelete := len(allSnapshots)
-	numberDeleted := 0
+	jobs := make([]func() error, 0, len(allSnapshots))
 	for _, snapshot := range allSnapshots {
-		logger.Debugf("Deleting share snapshot %q", snapshot.ID)
-		err = sharesnapshots.Delete(ctx, conn, snapshot.ID).ExtractErr()
-		if err != nil {
-			// Ignore the error if the share snapshot cannot be found
-			if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
-				// Just log the error and move on to the next share snapshot
-				logger.Debugf("Deleting share snapshot %q failed: %v", snapshot.ID, err)
-				continue
+		snapshot := snapshot
+		jobs = append(jobs, func() error {
+			logger.Debugf("Deleting share snapshot %q", snapshot.ID)
+			err := planOrDelete(ctx, "ShareSnapshot", snapshot.ID, snapshot.Name, nil, func() error {
+				return sharesnapshots.Delete(ctx, conn, snapshot.ID).ExtractErr()
+			})
+			if err != nil {
+				// Ignore the error if the share snapshot cannot be found
+				if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+					logger.Debugf("Cannot find share snapshot %q. It's probably already been deleted.", snapshot.ID)
+					return nil
+				}
+				if forceFromContext(ctx) {
+					if err := forceDeleteShareSnapshot(ctx, conn, snapshot.ID, logger); err != nil {
+						return fmt.Errorf("force-deleting share snapshot %q failed: %w", snapshot.ID, err)
+					}
+					return nil
+				}
+				return fmt.Errorf("deleting share snapshot %q failed: %w (re-run with Force set against an admin cloud to clear a stuck snapshot)", snapshot.ID, err)
 			}
-			logger.Debugf("Cannot find share snapshot %q. It's probably already been deleted.", snapshot.ID)
-		}
-		numberDeleted++
+			return nil
+		})
+	}
+	numberDeleted, err := parallelDelete(ctx, "sharesnapshots", jobs)
+	if err != nil {
+		logger.Debugf("Some share snapshots could not be deleted: %v", err)
 	}
 
 	return numberDeleted == numberToDelete, nil
@@ -1611,20 +3378,28 @@ func deleteFloatingIPs(ctx context.Context, opts *clientconfig.ClientOpts, filte
 	}
 
 	numberToDelete := len(allFloatingIPs)
-	numberDeleted := 0
+	jobs := make([]func() error, 0, len(allFloatingIPs))
 	for _, floatingIP := range allFloatingIPs {
-		logger.Debugf("Deleting Floating IP %q", floatingIP.ID)
-		err = floatingips.Delete(ctx, conn, floatingIP.ID).ExtractErr()
-		if err != nil {
-			// Ignore the error if the floating ip cannot be found
-			if !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
-				// Just log the error and move on to the next floating IP
-				logger.Debugf("Deleting floating ip %q failed: %v", floatingIP.ID, err)
-				continue
+		floatingIP := floatingIP
+		jobs = append(jobs, func() error {
+			logger.Debugf("Deleting Floating IP %q", floatingIP.ID)
+			err := planOrDelete(ctx, "FloatingIP", floatingIP.ID, floatingIP.FloatingIP, nil, func() error {
+				return floatingips.Delete(ctx, conn, floatingIP.ID).ExtractErr()
+			})
+			if err != nil {
+				// Ignore the error if the floating ip cannot be found
+				if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+					logger.Debugf("Cannot find floating ip %q. It's probably already been deleted.", floatingIP.ID)
+					return nil
+				}
+				return fmt.Errorf("deleting floating ip %q failed: %w", floatingIP.ID, err)
 			}
-			logger.Debugf("Cannot find floating ip %q. It's probably already been deleted.", floatingIP.ID)
-		}
-		numberDeleted++
+			return nil
+		})
+	}
+	numberDeleted, err := parallelDelete(ctx, "floatingips", jobs)
+	if err != nil {
+		logger.Debugf("Some floating ips could not be deleted: %v", err)
 	}
 	return numberDeleted == numberToDelete, nil
 }
@@ -1656,17 +3431,21 @@ func deleteImages(ctx context.Context, opts *clientconfig.ClientOpts, filter Fil
 	}
 
 	numberToDelete := len(allImages)
-	numberDeleted := 0
+	jobs := make([]func() error, 0, len(allImages))
 	for _, image := range allImages {
-		logger.Debugf("Deleting image: %+v", image.ID)
-		err := images.Delete(ctx, conn, image.ID).ExtractErr()
-		if err != nil {
-			// This can fail if the image is still in use by other VMs
-			// Just log the error and move on to the next image
-			logger.Debugf("Deleting Image failed: %v", err)
-			continue
-		}
-		numberDeleted++
+		image := image
+		jobs = append(jobs, func() error {
+			logger.Debugf("Deleting image: %+v", image.ID)
+			// This can fail if the image is still in use by other VMs;
+			// parallelDelete reports it as part of its aggregated error.
+			return planOrDelete(ctx, "Image", image.ID, image.Name, nil, func() error {
+				return images.Delete(ctx, conn, image.ID).ExtractErr()
+			})
+		})
+	}
+	numberDeleted, err := parallelDelete(ctx, "images", jobs)
+	if err != nil {
+		logger.Debugf("Some images could not be deleted: %v", err)
 	}
 	return numberDeleted == numberToDelete, nil
 }
@@ -1690,26 +3469,6 @@ func untagRunner(ctx context.Context, opts *clientconfig.ClientOpts, infraID str
 	return nil
 }
 
-func deleteRouterRunner(ctx context.Context, opts *clientconfig.ClientOpts, filter Filter, logger logrus.FieldLogger) error {
-	backoffSettings := wait.Backoff{
-		Duration: time.Second * 15,
-		Factor:   1.3,
-		Steps:    25,
-	}
-
-	err := wait.ExponentialBackoff(backoffSettings, func() (bool, error) {
-		return deleteRouters(ctx, opts, filter, logger)
-	})
-	if err != nil {
-		if err == wait.ErrWaitTimeout {
-			return err
-		}
-		return fmt.Errorf("unrecoverable error: %w", err)
-	}
-
-	return nil
-}
-
 // untagNetwork removes the tag from the primary cluster network based on unfra id
 func untagPrimaryNetwork(ctx context.Context, opts *clientconfig.ClientOpts, infraID string, logger logrus.FieldLogger) (bool, error) {
 	networkTag := infraID + "-primaryClusterNetwork"
@@ -1748,7 +3507,9 @@ func untagPrimaryNetwork(ctx context.Context, opts *clientconfig.ClientOpts, inf
 		return true, nil
 	}
 
-	err = attributestags.Delete(ctx, conn, "networks", allNetworks[0].ID, networkTag).ExtractErr()
+	err = planOrDelete(ctx, "NetworkTag", allNetworks[0].ID, allNetworks[0].Name, Filter{"tag": networkTag}, func() error {
+		return attributestags.Delete(ctx, conn, "networks", allNetworks[0].ID, networkTag).ExtractErr()
+	})
 	if err != nil {
 		return false, nil
 	}
@@ -1779,6 +3540,67 @@ func validateCloud(ctx context.Context, opts *clientconfig.ClientOpts, logger lo
 	return networkextensions.Validate(availableExtensions)
 }
 
+// applyProjectScope overrides the auth project/domain/user in opts with
+// whichever of ProjectID, ProjectName, DomainID and UserID the caller set, so
+// a single credential with access to multiple projects can be pointed at
+// exactly one of them.
+func applyProjectScope(opts *clientconfig.ClientOpts, o *ClusterUninstaller) {
+	if o.ProjectID == "" && o.ProjectName == "" && o.DomainID == "" && o.UserID == "" {
+		return
+	}
+	if opts.AuthInfo == nil {
+		opts.AuthInfo = &clientconfig.AuthInfo{}
+	}
+	if o.ProjectID != "" {
+		opts.AuthInfo.ProjectID = o.ProjectID
+	}
+	if o.ProjectName != "" {
+		opts.AuthInfo.ProjectName = o.ProjectName
+	}
+	if o.DomainID != "" {
+		opts.AuthInfo.DomainID = o.DomainID
+	}
+	if o.UserID != "" {
+		opts.AuthInfo.UserID = o.UserID
+	}
+}
+
+// validateProjectScope refuses to proceed when the authenticated token's
+// project doesn't match the requested ProjectID/ProjectName, preventing
+// accidental cross-project destruction when the same InfraID tag happens to
+// exist in more than one project.
+func validateProjectScope(ctx context.Context, opts *clientconfig.ClientOpts, o *ClusterUninstaller, logger logrus.FieldLogger) error {
+	if o.ProjectID == "" && o.ProjectName == "" {
+		return nil
+	}
+
+	conn, err := openstackdefaults.NewServiceClient(ctx, "network", opts)
+	if err != nil {
+		return fmt.Errorf("failed to build the network client to validate project scope: %w", err)
+	}
+
+	projectExtractor, ok := conn.ProviderClient.GetAuthResult().(interface {
+		ExtractProject() (*tokens.Project, error)
+	})
+	if !ok {
+		logger.Debug("Cannot determine the authenticated token's project scope for this auth method, skipping pre-flight check")
+		return nil
+	}
+
+	project, err := projectExtractor.ExtractProject()
+	if err != nil {
+		return fmt.Errorf("failed to extract the authenticated token's project: %w", err)
+	}
+
+	if o.ProjectID != "" && project.ID != o.ProjectID {
+		return fmt.Errorf("refusing to destroy: authenticated token is scoped to project %q, not the requested project %q", project.ID, o.ProjectID)
+	}
+	if o.ProjectName != "" && project.Name != o.ProjectName {
+		return fmt.Errorf("refusing to destroy: authenticated token is scoped to project %q, not the requested project %q", project.Name, o.ProjectName)
+	}
+	return nil
+}
+
 // cleanClusterSgs removes the installer security groups from the user provided Port.
 func cleanClusterSgs(providedPortSGs []string, clusterSGs []sg.SecGroup) []string {
 	var sgs []string
@@ -1840,6 +3662,12 @@ func cleanVIPsPorts(ctx context.Context, opts *clientconfig.ClientOpts, filter F
 			return false, nil
 		}
 		for _, port := range allPorts {
+			if report, ok := dryRunReportFromContext(ctx); ok {
+				report.add("VIPPort", port.ID, port.Name, Filter{"untagged": tag})
+				numberCleaned++
+				continue
+			}
+
 			logger.Debugf("Updating security groups for Port: %q", port.ID)
 			sgs := cleanClusterSgs(port.SecurityGroups, clusterSGs)
 			_, err := ports.Update(ctx, conn, port.ID, ports.UpdateOpts{SecurityGroups: &sgs}).Extract()
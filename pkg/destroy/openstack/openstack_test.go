@@ -0,0 +1,267 @@
+package openstack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"syscall"
+	"testing"
+
+	"github.com/gophercloud/utils/v2/openstack/clientconfig"
+	"github.com/sirupsen/logrus"
+)
+
+func testLogger() logrus.FieldLogger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return logger
+}
+
+func nodeNames(nodes []dagNode) []string {
+	names := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		names = append(names, node.name)
+	}
+	return names
+}
+
+func TestKindName(t *testing.T) {
+	tests := map[string]string{
+		"deleteRouters":         "routers",
+		"deletePorts":           "ports",
+		"cleanVIPsPorts":        "vipsports",
+		"clearRouterInterfaces": "routerinterfaces",
+		"reapAmphoraResources":  "amphoraresources",
+		"somethingElse":         "somethingelse",
+	}
+	for nodeName, want := range tests {
+		if got := kindName(nodeName); got != want {
+			t.Errorf("kindName(%q) = %q, want %q", nodeName, got, want)
+		}
+	}
+}
+
+func TestFilterDAG(t *testing.T) {
+	nodes := []dagNode{
+		{name: "deleteRouters"},
+		{name: "deletePorts"},
+		{name: "deleteNetworks"},
+	}
+
+	t.Run("no filter keeps every node", func(t *testing.T) {
+		got := nodeNames(filterDAG(nodes, nil, nil))
+		want := []string{"deleteRouters", "deletePorts", "deleteNetworks"}
+		if !reflectEqual(got, want) {
+			t.Errorf("filterDAG(nil, nil) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("only restricts to the named kinds", func(t *testing.T) {
+		got := nodeNames(filterDAG(nodes, []string{"Routers"}, nil))
+		want := []string{"deleteRouters"}
+		if !reflectEqual(got, want) {
+			t.Errorf("filterDAG(only=routers) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("skip takes precedence over only", func(t *testing.T) {
+		got := nodeNames(filterDAG(nodes, []string{"routers", "ports"}, []string{"ROUTERS"}))
+		want := []string{"deletePorts"}
+		if !reflectEqual(got, want) {
+			t.Errorf("filterDAG(only, skip) = %v, want %v", got, want)
+		}
+	})
+}
+
+func reflectEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestRunDAGOrderingAndMetrics(t *testing.T) {
+	var order []string
+
+	nodes := []dagNode{
+		{name: "deleteNetworks", dependsOn: []string{"deleteSubnets"}, fn: func(ctx context.Context, opts *clientconfig.ClientOpts, filter Filter, logger logrus.FieldLogger) (bool, error) {
+			order = append(order, "deleteNetworks")
+			return true, nil
+		}},
+		{name: "deleteSubnets", fn: func(ctx context.Context, opts *clientconfig.ClientOpts, filter Filter, logger logrus.FieldLogger) (bool, error) {
+			order = append(order, "deleteSubnets")
+			return true, nil
+		}},
+	}
+
+	metrics, err := runDAG(context.Background(), nodes, nil, Filter{}, testLogger(), nil)
+	if err != nil {
+		t.Fatalf("runDAG returned error: %v", err)
+	}
+	if len(order) != 2 || order[0] != "deleteSubnets" || order[1] != "deleteNetworks" {
+		t.Errorf("runDAG ran nodes out of dependency order: %v", order)
+	}
+	if len(metrics) != 2 {
+		t.Fatalf("expected 2 metrics entries, got %d", len(metrics))
+	}
+	for _, m := range metrics {
+		if m.Attempts != 1 {
+			t.Errorf("node %q: expected 1 attempt on success, got %d", m.Name, m.Attempts)
+		}
+		if m.Error != "" {
+			t.Errorf("node %q: unexpected error %q", m.Name, m.Error)
+		}
+	}
+}
+
+func TestParallelDeleteAggregatesErrors(t *testing.T) {
+	errBoom := errors.New("boom")
+	jobs := []func() error{
+		func() error { return nil },
+		func() error { return errBoom },
+		func() error { return nil },
+		func() error { return fmt.Errorf("wrapped: %w", errBoom) },
+	}
+
+	deleted, err := parallelDelete(context.Background(), "test-kind", jobs)
+	if deleted != 2 {
+		t.Errorf("parallelDelete deleted = %d, want 2", deleted)
+	}
+	if err == nil {
+		t.Fatal("expected an aggregated error, got nil")
+	}
+}
+
+func TestPlanOrDeleteDryRunSkipsDelete(t *testing.T) {
+	report := newDryRunReport()
+	ctx := withDryRunReport(context.Background(), report)
+
+	called := false
+	err := planOrDelete(ctx, "Port", "port-id", "port-name", Filter{"openshiftClusterID": "abcd1234"}, func() error {
+		called = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("planOrDelete returned error in dry-run mode: %v", err)
+	}
+	if called {
+		t.Error("planOrDelete called del while a dry-run report was installed on the context")
+	}
+
+	objects := report.Resources["Port"]
+	if len(objects) != 1 || objects[0].ID != "port-id" {
+		t.Errorf("report.Resources[%q] = %+v, want a single Port with ID %q", "Port", objects, "port-id")
+	}
+}
+
+func TestDestroyPoolRunSkipsOnCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	pool := newDestroyPool(1, 0)
+	ran := false
+	pool.run(ctx, func() { ran = true })
+
+	if ran {
+		t.Error("destroyPool.run called fn after its context was already canceled")
+	}
+}
+
+func TestDestroyProgressRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	want := destroyProgress{
+		ClusterID: "abcd1234",
+		Finished:  []string{"volumes", "images"},
+		Pending:   []string{"networks", "routers"},
+	}
+
+	if err := writeProgressCheckpoint(dir, want); err != nil {
+		t.Fatalf("writeProgressCheckpoint: %v", err)
+	}
+
+	got, err := readProgressCheckpoint(dir, want.ClusterID)
+	if err != nil {
+		t.Fatalf("readProgressCheckpoint: %v", err)
+	}
+	if got == nil {
+		t.Fatal("readProgressCheckpoint returned nil progress for a file that was just written")
+	}
+	if got.ClusterID != want.ClusterID || !reflectEqual(got.Finished, want.Finished) || !reflectEqual(got.Pending, want.Pending) {
+		t.Errorf("round-tripped progress = %+v, want %+v", got, want)
+	}
+}
+
+func TestReadProgressCheckpointMissingFileIsNotAnError(t *testing.T) {
+	dir := t.TempDir()
+	got, err := readProgressCheckpoint(dir, "does-not-exist")
+	if err != nil {
+		t.Fatalf("expected no error for a missing checkpoint, got %v", err)
+	}
+	if got != nil {
+		t.Fatalf("expected nil progress for a missing checkpoint, got %+v", got)
+	}
+}
+
+func TestProgressCheckpointPathIsValidJSON(t *testing.T) {
+	dir := t.TempDir()
+	progress := destroyProgress{ClusterID: "xyz", Finished: []string{"volumes"}}
+	if err := writeProgressCheckpoint(dir, progress); err != nil {
+		t.Fatalf("writeProgressCheckpoint: %v", err)
+	}
+
+	data, err := os.ReadFile(progressCheckpointPath(dir, "xyz"))
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	var decoded destroyProgress
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("checkpoint file is not valid JSON: %v", err)
+	}
+}
+
+func TestNextSignalAction(t *testing.T) {
+	tests := []struct {
+		name    string
+		sig     os.Signal
+		strikes int
+		want    signalAction
+	}{
+		{"SIGQUIT never counts as a strike", syscall.SIGQUIT, 1, signalActionDumpStacks},
+		{"first SIGINT checkpoints", syscall.SIGINT, 1, signalActionCheckpoint},
+		{"second SIGTERM checkpoints and cancels", syscall.SIGTERM, 2, signalActionCheckpointAndCancel},
+		{"third signal exits", syscall.SIGINT, 3, signalActionExit},
+		{"any further signal keeps exiting", syscall.SIGTERM, 4, signalActionExit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nextSignalAction(tt.sig, tt.strikes); got != tt.want {
+				t.Errorf("nextSignalAction(%v, %d) = %v, want %v", tt.sig, tt.strikes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewProgressTrackerSnapshot(t *testing.T) {
+	nodes := []dagNode{{name: "deleteVolumes"}, {name: "deleteNetworks"}}
+	tracker := newProgressTracker(nodes)
+	tracker.markFinished("deleteVolumes")
+
+	snap := tracker.snapshot("cluster-id")
+	sort.Strings(snap.Finished)
+	sort.Strings(snap.Pending)
+	if !reflectEqual(snap.Finished, []string{"volumes"}) {
+		t.Errorf("snapshot.Finished = %v, want [volumes]", snap.Finished)
+	}
+	if !reflectEqual(snap.Pending, []string{"networks"}) {
+		t.Errorf("snapshot.Pending = %v, want [networks]", snap.Pending)
+	}
+}